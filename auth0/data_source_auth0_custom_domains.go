@@ -0,0 +1,78 @@
+package auth0
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func newDataSourceCustomDomains() *schema.Resource {
+	return &schema.Resource{
+
+		Read: readDataSourceCustomDomains,
+
+		Schema: map[string]*schema.Schema{
+			"custom_domains": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"domain": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"primary": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"primary_domain": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func readDataSourceCustomDomains(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerMeta)
+	// The custom domains endpoint isn't paginated, all domains are returned in
+	// a single response, so list_page_size doesn't apply.
+	domains, err := api.CustomDomain.List()
+	if err != nil {
+		return err
+	}
+
+	customDomains := make([]interface{}, 0, len(domains))
+	var primaryDomain string
+	for _, c := range domains {
+		customDomains = append(customDomains, map[string]interface{}{
+			"id":      c.GetID(),
+			"domain":  c.GetDomain(),
+			"type":    c.GetType(),
+			"primary": c.GetPrimary(),
+			"status":  c.GetStatus(),
+		})
+		if c.GetPrimary() {
+			primaryDomain = c.GetDomain()
+		}
+	}
+
+	d.SetId(resource.UniqueId())
+	d.Set("custom_domains", customDomains)
+	d.Set("primary_domain", primaryDomain)
+	return nil
+}
@@ -0,0 +1,45 @@
+package auth0
+
+import (
+	"testing"
+
+	"github.com/alexkappa/terraform-provider-auth0/auth0/internal/random"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccDataSourceCustomDomains(t *testing.T) {
+
+	rand := random.String(6)
+
+	resource.Test(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"auth0": Provider(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: random.Template(testAccDataSourceCustomDomains, rand),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.auth0_custom_domains.all", "custom_domains.#", "1"),
+					random.TestCheckResourceAttr("data.auth0_custom_domains.all", "custom_domains.0.domain", "{{.random}}.auth.uat.alexkappa.com", rand),
+					resource.TestCheckResourceAttr("data.auth0_custom_domains.all", "custom_domains.0.type", "auth0_managed_certs"),
+					resource.TestCheckResourceAttr("data.auth0_custom_domains.all", "custom_domains.0.status", "pending_verification"),
+					resource.TestCheckResourceAttrPair("data.auth0_custom_domains.all", "custom_domains.0.id", "auth0_custom_domain.my_custom_domain", "id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceCustomDomains = `
+
+resource "auth0_custom_domain" "my_custom_domain" {
+  domain = "{{.random}}.auth.uat.alexkappa.com"
+  type = "auth0_managed_certs"
+  verification_method = "txt"
+}
+
+data "auth0_custom_domains" "all" {
+  depends_on = ["auth0_custom_domain.my_custom_domain"]
+}
+`
@@ -0,0 +1,76 @@
+package auth0
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"gopkg.in/auth0.v4/management"
+)
+
+func newDataSourceResourceServers() *schema.Resource {
+	return &schema.Resource{
+
+		Read: readDataSourceResourceServers,
+
+		Schema: map[string]*schema.Schema{
+			"name_filter": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"resource_servers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"identifier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func readDataSourceResourceServers(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerMeta)
+	filter := strings.ToLower(d.Get("name_filter").(string))
+
+	resourceServers := make([]interface{}, 0)
+	var page int
+	for {
+		l, err := api.ResourceServer.List(management.Page(page), api.perPage())
+		if err != nil {
+			return err
+		}
+		for _, s := range l.ResourceServers {
+			if !strings.Contains(strings.ToLower(s.GetName()), filter) {
+				continue
+			}
+			resourceServers = append(resourceServers, map[string]interface{}{
+				"id":         s.GetID(),
+				"identifier": s.GetIdentifier(),
+				"name":       s.GetName(),
+			})
+		}
+		if !l.HasNext() {
+			break
+		}
+		page++
+	}
+
+	d.SetId(resource.UniqueId())
+	d.Set("resource_servers", resourceServers)
+	return nil
+}
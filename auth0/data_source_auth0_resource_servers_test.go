@@ -0,0 +1,44 @@
+package auth0
+
+import (
+	"testing"
+
+	"github.com/alexkappa/terraform-provider-auth0/auth0/internal/random"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccDataSourceResourceServers(t *testing.T) {
+
+	rand := random.String(6)
+
+	resource.Test(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"auth0": Provider(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: random.Template(testAccDataSourceResourceServers, rand),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.auth0_resource_servers.filtered", "resource_servers.#", "1"),
+					random.TestCheckResourceAttr("data.auth0_resource_servers.filtered", "resource_servers.0.name", "Acceptance Test - Data Source - {{.random}}", rand),
+					random.TestCheckResourceAttr("data.auth0_resource_servers.filtered", "resource_servers.0.identifier", "https://uat.tf.alexkappa.com/data-source/{{.random}}", rand),
+					resource.TestCheckResourceAttrPair("data.auth0_resource_servers.filtered", "resource_servers.0.id", "auth0_resource_server.my_resource_server", "id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceResourceServers = `
+
+resource "auth0_resource_server" "my_resource_server" {
+  name = "Acceptance Test - Data Source - {{.random}}"
+  identifier = "https://uat.tf.alexkappa.com/data-source/{{.random}}"
+}
+
+data "auth0_resource_servers" "filtered" {
+  name_filter = "data source - {{.random}}"
+  depends_on = ["auth0_resource_server.my_resource_server"]
+}
+`
@@ -0,0 +1,53 @@
+package auth0
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"gopkg.in/auth0.v4/management"
+)
+
+func newDataSourceTenant() *schema.Resource {
+	return &schema.Resource{
+
+		Read: readDataSourceTenant,
+
+		Schema: map[string]*schema.Schema{
+			"friendly_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"flags": {
+				Type:     schema.TypeMap,
+				Elem:     &schema.Schema{Type: schema.TypeBool},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func readDataSourceTenant(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerMeta)
+	t, err := api.Tenant.Read()
+	if err != nil {
+		return withRequiredScope(err, "read:tenant_settings")
+	}
+
+	d.SetId(resource.UniqueId())
+	d.Set("friendly_name", t.FriendlyName)
+	d.Set("flags", flattenDataSourceTenantFlags(t.Flags))
+	return nil
+}
+
+// flattenDataSourceTenantFlags returns the tenant flags as a map of booleans.
+// Flags which are not set on the tenant are omitted rather than reported as
+// false, so their absence can be told apart from them being disabled.
+func flattenDataSourceTenantFlags(flags *management.TenantFlags) map[string]interface{} {
+	m := make(map[string]interface{})
+	for k, v := range flattenTenantFlags(flags)[0].(map[string]interface{}) {
+		if b, ok := v.(*bool); ok && b != nil {
+			m[k] = *b
+		}
+	}
+	return m
+}
@@ -0,0 +1,63 @@
+package auth0
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"gopkg.in/auth0.v4"
+	"gopkg.in/auth0.v4/management"
+)
+
+func TestAccDataSourceTenant(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"auth0": Provider(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceTenantConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.auth0_tenant.current", "friendly_name"),
+					resource.TestCheckResourceAttr("data.auth0_tenant.current", "flags.enable_client_connections", "true"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceTenantConfig = `
+
+resource "auth0_tenant" "my_tenant" {
+	flags {
+		enable_client_connections = true
+	}
+}
+
+data "auth0_tenant" "current" {
+	depends_on = ["auth0_tenant.my_tenant"]
+}
+`
+
+func TestFlattenDataSourceTenantFlags(t *testing.T) {
+
+	flags := flattenDataSourceTenantFlags(&management.TenantFlags{
+		EnableClientConnections: auth0.Bool(true),
+		EnableAPIsSection:       auth0.Bool(false),
+	})
+
+	if len(flags) != 2 {
+		t.Errorf("Expected 2 flags, got %d: %v", len(flags), flags)
+	}
+	if flags["enable_client_connections"] != true {
+		t.Errorf("Expected enable_client_connections to be true, got %v", flags["enable_client_connections"])
+	}
+	if flags["enable_apis_section"] != false {
+		t.Errorf("Expected enable_apis_section to be false, got %v", flags["enable_apis_section"])
+	}
+
+	if flags := flattenDataSourceTenantFlags(nil); len(flags) != 0 {
+		t.Errorf("Expected no flags, got %v", flags)
+	}
+}
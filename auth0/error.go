@@ -0,0 +1,21 @@
+package auth0
+
+import (
+	"fmt"
+	"net/http"
+
+	"gopkg.in/auth0.v4/management"
+)
+
+// withRequiredScope annotates a forbidden error with the scope the token used
+// by the provider must be granted in order to perform the request. The original
+// error is wrapped, so it can still be inspected. Other errors are returned
+// unchanged.
+func withRequiredScope(err error, scope string) error {
+	if mErr, ok := err.(management.Error); ok {
+		if mErr.Status() == http.StatusForbidden {
+			return fmt.Errorf("%w: the management API token must be granted the %q scope", err, scope)
+		}
+	}
+	return err
+}
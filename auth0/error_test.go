@@ -0,0 +1,37 @@
+package auth0
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+type testManagementError struct {
+	status int
+}
+
+func (e testManagementError) Error() string { return http.StatusText(e.status) }
+func (e testManagementError) Status() int   { return e.status }
+
+func TestWithRequiredScope(t *testing.T) {
+
+	err := withRequiredScope(testManagementError{http.StatusForbidden}, "read:tenant_settings")
+	if !strings.Contains(err.Error(), `"read:tenant_settings"`) {
+		t.Errorf("Expected error to name the missing scope, got %q", err)
+	}
+	var mErr testManagementError
+	if !errors.As(err, &mErr) || mErr.Status() != http.StatusForbidden {
+		t.Errorf("Expected error to wrap the forbidden error, got %v", err)
+	}
+
+	for _, expected := range []error{
+		testManagementError{http.StatusInternalServerError},
+		errors.New("connection refused"),
+		nil,
+	} {
+		if err := withRequiredScope(expected, "read:tenant_settings"); err != expected {
+			t.Errorf("Expected error %v to be returned unchanged, got %v", expected, err)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package diff
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// IgnoreWhitespace is a SchemaDiffSuppressFunc which suppresses the diff when
+// the old and new values only differ in their whitespace. This is useful for
+// HTML or script bodies which are often reformatted between the configuration
+// and the API.
+func IgnoreWhitespace(k, old, new string, d *schema.ResourceData) bool {
+	return normalizeWhitespace(old) == normalizeWhitespace(new)
+}
+
+func normalizeWhitespace(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// IgnoreSurroundingWhitespace is a SchemaDiffSuppressFunc which suppresses the
+// diff when the old and new values only differ in leading or trailing
+// whitespace, for values the API trims before storing them.
+func IgnoreSurroundingWhitespace(k, old, new string, d *schema.ResourceData) bool {
+	return strings.TrimSpace(old) == strings.TrimSpace(new)
+}
+
+// IgnoreScriptFormatting is a SchemaDiffSuppressFunc which suppresses the diff
+// when the old and new scripts only differ in line endings, trailing whitespace
+// or surrounding blank lines. Unlike IgnoreWhitespace, line breaks within the
+// script remain significant.
+func IgnoreScriptFormatting(k, old, new string, d *schema.ResourceData) bool {
+	return normalizeScript(old) == normalizeScript(new)
+}
+
+func normalizeScript(s string) string {
+	lines := strings.Split(strings.Replace(s, "\r\n", "\n", -1), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t")
+	}
+	return strings.Trim(strings.Join(lines, "\n"), "\n")
+}
+
+// IgnoreURLNormalization is a SchemaDiffSuppressFunc which suppresses the diff
+// when the old and new values are the same URL, differing only in a trailing
+// slash or in the case of their scheme and host.
+func IgnoreURLNormalization(k, old, new string, d *schema.ResourceData) bool {
+	return normalizeURL(old) == normalizeURL(new)
+}
+
+func normalizeURL(s string) string {
+	u, err := url.Parse(s)
+	if err != nil {
+		return s
+	}
+	u.Scheme = strings.ToLower(u.Scheme)
+	u.Host = strings.ToLower(u.Host)
+	u.Path = strings.TrimSuffix(u.Path, "/")
+	return u.String()
+}
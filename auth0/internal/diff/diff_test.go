@@ -0,0 +1,74 @@
+package diff
+
+import "testing"
+
+func TestIgnoreWhitespace(t *testing.T) {
+	for _, test := range []struct {
+		old, new string
+		suppress bool
+	}{
+		{"<html>Error</html>", "<html>Error</html>", true},
+		{"<html>Error</html>", "<html>Error</html>\n", true},
+		{"<html>\n  <body>Error</body>\n</html>", "<html> <body>Error</body> </html>", true},
+		{"<html>Error</html>", "<html>Oops</html>", false},
+		{"<html>Error</html>", "", false},
+	} {
+		if got := IgnoreWhitespace("html", test.old, test.new, nil); got != test.suppress {
+			t.Errorf("IgnoreWhitespace(%q, %q) = %t, expected %t", test.old, test.new, got, test.suppress)
+		}
+	}
+}
+
+func TestIgnoreSurroundingWhitespace(t *testing.T) {
+	for _, test := range []struct {
+		old, new string
+		suppress bool
+	}{
+		{"Create foos", "Create foos", true},
+		{"Create foos", "  Create foos\n", true},
+		{"Create foos", "Create  foos", false},
+		{"Create foos", "Create bars", false},
+	} {
+		if got := IgnoreSurroundingWhitespace("description", test.old, test.new, nil); got != test.suppress {
+			t.Errorf("IgnoreSurroundingWhitespace(%q, %q) = %t, expected %t", test.old, test.new, got, test.suppress)
+		}
+	}
+}
+
+func TestIgnoreScriptFormatting(t *testing.T) {
+	for _, test := range []struct {
+		old, new string
+		suppress bool
+	}{
+		{"function login() {\n}", "function login() {\n}", true},
+		{"function login() {\n}", "function login() {\n}\n", true},
+		{"function login() {\r\n}\r\n", "function login() {\n}", true},
+		{"function login() {  \n}", "function login() {\n}", true},
+		{"function login() {\n}", "function login() { }", false},
+		{"function login() {\n  return 1\n}", "function login() {\n  return 2\n}", false},
+	} {
+		if got := IgnoreScriptFormatting("script", test.old, test.new, nil); got != test.suppress {
+			t.Errorf("IgnoreScriptFormatting(%q, %q) = %t, expected %t", test.old, test.new, got, test.suppress)
+		}
+	}
+}
+
+func TestIgnoreURLNormalization(t *testing.T) {
+	for _, test := range []struct {
+		old, new string
+		suppress bool
+	}{
+		{"https://example.com", "https://example.com", true},
+		{"https://example.com/", "https://example.com", true},
+		{"https://example.com/logout/", "https://example.com/logout", true},
+		{"HTTPS://Example.COM/logout", "https://example.com/logout", true},
+		{"https://example.com/Logout", "https://example.com/logout", false},
+		{"https://example.com/logout?a=b", "https://example.com/logout?a=c", false},
+		{"https://example.com", "https://example.org", false},
+		{"https://example.com", "", false},
+	} {
+		if got := IgnoreURLNormalization("url", test.old, test.new, nil); got != test.suppress {
+			t.Errorf("IgnoreURLNormalization(%q, %q) = %t, expected %t", test.old, test.new, got, test.suppress)
+		}
+	}
+}
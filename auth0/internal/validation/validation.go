@@ -2,6 +2,7 @@ package validation
 
 import (
 	"fmt"
+	"net/mail"
 	"net/url"
 )
 
@@ -37,3 +38,50 @@ func IsURLWithNoFragment(i interface{}, k string) (warnings []string, errors []e
 
 	return
 }
+
+// IsOrigin is a SchemaValidateFunc which tests if the provided value is of
+// type string and a valid origin, made up of a scheme, a host and an optional
+// port, with no path, query or fragment.
+func IsOrigin(i interface{}, k string) (warnings []string, errors []error) {
+
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	u, err := url.Parse(v)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("expected %q to be a valid origin, got %v: %+v", k, v, err))
+		return
+	}
+
+	if u.Scheme == "" || u.Host == "" {
+		errors = append(errors, fmt.Errorf("expected %q to have a scheme and a host, got %v", k, v))
+		return
+	}
+
+	if (u.Path != "" && u.Path != "/") || u.RawQuery != "" || u.Fragment != "" || u.User != nil {
+		errors = append(errors, fmt.Errorf("expected %q to be an origin with no path, query or fragment, got %v", k, v))
+	}
+
+	return
+}
+
+// IsEmail is a SchemaValidateFunc which tests if the provided value is of type
+// string and a bare email address, such as "support@example.com".
+func IsEmail(i interface{}, k string) (warnings []string, errors []error) {
+
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	a, err := mail.ParseAddress(v)
+	if err != nil || a.Address != v {
+		errors = append(errors, fmt.Errorf("expected %q to be a valid email address, got %v", k, v))
+	}
+
+	return
+}
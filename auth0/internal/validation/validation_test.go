@@ -16,3 +16,44 @@ func TestIsURLWithNoFragment(t *testing.T) {
 		}
 	}
 }
+
+func TestIsOrigin(t *testing.T) {
+	for origin, valid := range map[string]bool{
+		"https://example.com":          true,
+		"https://example.com/":         true,
+		"http://localhost:3000":        true,
+		"https://*.example.com":        true,
+		"https://example.com/callback": false,
+		"https://example.com?foo=bar":  false,
+		"https://example.com#foo":      false,
+		"example.com":                  false,
+		"":                             false,
+	} {
+		_, errs := IsOrigin(origin, "origin")
+		if len(errs) > 0 && valid {
+			t.Errorf("IsOrigin(%q) produced an unexpected error: %v", origin, errs)
+		}
+		if len(errs) == 0 && !valid {
+			t.Errorf("IsOrigin(%q) was expected to produce an error", origin)
+		}
+	}
+}
+
+func TestIsEmail(t *testing.T) {
+	for email, valid := range map[string]bool{
+		"support@example.com":           true,
+		"support@mysite":                true,
+		"support":                       false,
+		"support@":                      false,
+		"Support <support@example.com>": false,
+		"":                              false,
+	} {
+		_, errs := IsEmail(email, "email")
+		if len(errs) > 0 && valid {
+			t.Errorf("IsEmail(%q) produced an unexpected error: %v", email, errs)
+		}
+		if len(errs) == 0 && !valid {
+			t.Errorf("IsEmail(%q) was expected to produce an error", email)
+		}
+	}
+}
@@ -0,0 +1,17 @@
+package auth0
+
+import "gopkg.in/auth0.v4/management"
+
+// defaultListPageSize is the number of items requested per page by list
+// operations, unless the provider is configured with list_page_size.
+const defaultListPageSize = 50
+
+// perPage returns a list option requesting the configured number of items per
+// page, or defaultListPageSize if none was configured. It should be passed to
+// every paginated list call.
+func (m *providerMeta) perPage() management.ListOption {
+	if m.listPageSize > 0 {
+		return management.PerPage(m.listPageSize)
+	}
+	return management.PerPage(defaultListPageSize)
+}
@@ -0,0 +1,51 @@
+package auth0
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestListPageSize(t *testing.T) {
+
+	var perPage string
+	api, done := testAPIStub(t, func(w http.ResponseWriter, r *http.Request) {
+		perPage = r.URL.Query().Get("per_page")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"clients":[],"start":0,"limit":0,"total":0}`))
+	})
+	defer done()
+
+	for size, expected := range map[int]string{
+		0:   "50",
+		100: "100",
+	} {
+		m := &providerMeta{Management: api, listPageSize: size}
+		if _, err := newClientReadCache(m).list(); err != nil {
+			t.Fatal(err)
+		}
+		if perPage != expected {
+			t.Errorf("Expected per_page %s, got %q", expected, perPage)
+		}
+	}
+}
+
+func TestProvider_listPageSizeValidation(t *testing.T) {
+
+	validate := Provider().Schema["list_page_size"].ValidateFunc
+
+	for size, valid := range map[int]bool{
+		0:   false,
+		1:   true,
+		50:  true,
+		100: true,
+		101: false,
+	} {
+		_, errs := validate(size, "list_page_size")
+		if valid && len(errs) > 0 {
+			t.Errorf("Expected %d to be valid, got %v", size, errs)
+		}
+		if !valid && len(errs) == 0 {
+			t.Errorf("Expected %d to be invalid", size)
+		}
+	}
+}
@@ -3,9 +3,13 @@ package auth0
 import (
 	"fmt"
 	"os"
+	"runtime"
+	"strings"
 
 	"github.com/alexkappa/terraform-provider-auth0/version"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/mutexkv"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/hashicorp/terraform-plugin-sdk/meta"
 
 	"gopkg.in/auth0.v4"
@@ -14,6 +18,11 @@ import (
 
 var provider *schema.Provider
 
+// globalMutexKV is used to serialize read-modify-write cycles on Auth0 objects
+// that are managed by more than one resource, e.g. a connection's enabled
+// clients.
+var globalMutexKV = mutexkv.NewMutexKV()
+
 func init() {
 	provider = &schema.Provider{
 		Schema: map[string]*schema.Schema{
@@ -43,23 +52,44 @@ func init() {
 					return v == "1" || v == "true" || v == "on", nil
 				},
 			},
+			"enable_read_cache": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"list_page_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      defaultListPageSize,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"auth0_client":          newClient(),
-			"auth0_global_client":   newGlobalClient(),
-			"auth0_client_grant":    newClientGrant(),
-			"auth0_connection":      newConnection(),
-			"auth0_custom_domain":   newCustomDomain(),
-			"auth0_resource_server": newResourceServer(),
-			"auth0_rule":            newRule(),
-			"auth0_rule_config":     newRuleConfig(),
-			"auth0_hook":            newHook(),
-			"auth0_prompt":          newPrompt(),
-			"auth0_email":           newEmail(),
-			"auth0_email_template":  newEmailTemplate(),
-			"auth0_user":            newUser(),
-			"auth0_tenant":          newTenant(),
-			"auth0_role":            newRole(),
+			"auth0_client":                newClient(),
+			"auth0_global_client":         newGlobalClient(),
+			"auth0_client_grant":          newClientGrant(),
+			"auth0_connection":            newConnection(),
+			"auth0_connection_client":     newConnectionClient(),
+			"auth0_custom_domain":         newCustomDomain(),
+			"auth0_resource_server":       newResourceServer(),
+			"auth0_resource_server_scope": newResourceServerScope(),
+			"auth0_rule":                  newRule(),
+			"auth0_rule_config":           newRuleConfig(),
+			"auth0_hook":                  newHook(),
+			"auth0_hook_secrets":          newHookSecrets(),
+			"auth0_prompt":                newPrompt(),
+			"auth0_email":                 newEmail(),
+			"auth0_email_template":        newEmailTemplate(),
+			"auth0_email_templates":       newEmailTemplates(),
+			"auth0_user":                  newUser(),
+			"auth0_tenant":                newTenant(),
+			"auth0_role":                  newRole(),
+			"auth0_role_permissions":      newRolePermissions(),
+		},
+		DataSourcesMap: map[string]*schema.Resource{
+			"auth0_custom_domains":   newDataSourceCustomDomains(),
+			"auth0_resource_servers": newDataSourceResourceServers(),
+			"auth0_tenant":           newDataSourceTenant(),
 		},
 		ConfigureFunc: Configure,
 	}
@@ -76,15 +106,33 @@ func Configure(data *schema.ResourceData) (interface{}, error) {
 	secret := data.Get("client_secret").(string)
 	debug := data.Get("debug").(bool)
 
-	userAgent := fmt.Sprintf("Terraform-Provider-Auth0/%s (Go-Auth0-SDK/%s; Terraform-SDK/%s; Terraform/%s)",
+	api, err := management.New(domain, id, secret,
+		management.WithDebug(debug),
+		management.WithUserAgent(UserAgent()))
+	if err != nil {
+		return nil, err
+	}
+
+	m := &providerMeta{
+		Management:   api,
+		listPageSize: data.Get("list_page_size").(int),
+	}
+	if data.Get("enable_read_cache").(bool) {
+		m.clientReadCache = newClientReadCache(m)
+	}
+
+	return m, nil
+}
+
+// UserAgent returns the User-Agent sent with every request made to the Auth0
+// Management API, identifying the provider, SDK, Terraform and Go versions.
+func UserAgent() string {
+	return fmt.Sprintf("Terraform-Provider-Auth0/%s (Go-Auth0-SDK/%s; Terraform-SDK/%s; Terraform/%s; Go/%s)",
 		Version(),
 		SDKVersion(),
 		TerraformSDKVersion(),
-		TerraformVersion())
-
-	return management.New(domain, id, secret,
-		management.WithDebug(debug),
-		management.WithUserAgent(userAgent))
+		TerraformVersion(),
+		GoVersion())
 }
 
 func Version() string {
@@ -102,3 +150,7 @@ func TerraformVersion() string {
 func TerraformSDKVersion() string {
 	return meta.SDKVersionString()
 }
+
+func GoVersion() string {
+	return strings.TrimPrefix(runtime.Version(), "go")
+}
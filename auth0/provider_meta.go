@@ -0,0 +1,18 @@
+package auth0
+
+import "gopkg.in/auth0.v4/management"
+
+// providerMeta is returned by Configure and passed to every resource and data
+// source. It embeds the Management API client, and holds state scoped to the
+// configured provider instance, so it is released along with it.
+type providerMeta struct {
+	*management.Management
+
+	// clientReadCache is nil unless the provider is configured with
+	// enable_read_cache.
+	clientReadCache *clientReadCache
+
+	// listPageSize is the number of items requested per page by list
+	// operations, as configured with list_page_size.
+	listPageSize int
+}
@@ -1,12 +1,18 @@
 package auth0
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"runtime"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 
+	"golang.org/x/oauth2"
 	"gopkg.in/auth0.v4/management"
 )
 
@@ -16,7 +22,34 @@ func Auth0() (*management.Management, error) {
 	if err := p.Configure(c); err != nil {
 		return nil, err
 	}
-	return p.Meta().(*management.Management), nil
+	return p.Meta().(*providerMeta).Management, nil
+}
+
+// testAPIStub returns a Management API client backed by a local server, which
+// issues tokens and serves every other request with handler. The client sends
+// the same User-Agent as the one built by Configure. The returned function
+// stops the server.
+func testAPIStub(t *testing.T, handler http.HandlerFunc) (*management.Management, func()) {
+	t.Helper()
+
+	s := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/oauth/token" {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"access_token":"token","token_type":"Bearer","expires_in":86400}`))
+			return
+		}
+		handler(w, r)
+	}))
+
+	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, s.Client())
+	api, err := management.New(strings.TrimPrefix(s.URL, "https://"), "id", "secret",
+		management.WithContext(ctx),
+		management.WithUserAgent(UserAgent()))
+	if err != nil {
+		s.Close()
+		t.Fatal(err)
+	}
+	return api, s.Close
 }
 
 func TestMain(m *testing.M) {
@@ -56,3 +89,31 @@ func TestProvider_debugDefaults(t *testing.T) {
 		}
 	}
 }
+
+func TestProvider_userAgent(t *testing.T) {
+
+	var ua string
+	api, done := testAPIStub(t, func(w http.ResponseWriter, r *http.Request) {
+		ua = r.Header.Get("User-Agent")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{}`))
+	})
+	defer done()
+
+	if _, err := api.Tenant.Read(); err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.HasPrefix(ua, "Terraform-Provider-Auth0/"+Version()+" ") {
+		t.Errorf("Expected User-Agent %q to start with the provider name and version", ua)
+	}
+	for _, expected := range []string{
+		"Go-Auth0-SDK/" + SDKVersion() + ";",
+		"Terraform-SDK/" + TerraformSDKVersion() + ";",
+		"Go/" + strings.TrimPrefix(runtime.Version(), "go") + ")",
+	} {
+		if !strings.Contains(ua, expected) {
+			t.Errorf("Expected User-Agent %q to contain %q", ua, expected)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package auth0
+
+import (
+	"sync"
+
+	"gopkg.in/auth0.v4/management"
+)
+
+// clientReadCache reduces the number of requests made when reading a large
+// number of auth0_client resources, for example during a plan. The first read
+// lists all clients in bulk, subsequent reads are served from memory and fall
+// back to reading a single client if it wasn't part of the listing.
+//
+// The cache is held by the provider meta, so it lives for as long as the
+// provider is configured, which is a single plan or apply. It is only used if
+// the provider is configured with enable_read_cache, as clients changed outside
+// of Terraform during that time won't be seen. Other objects are not cached.
+type clientReadCache struct {
+	list func() ([]*management.Client, error)
+	read func(id string) (*management.Client, error)
+
+	mu      sync.Mutex
+	loaded  bool
+	clients map[string]*management.Client
+}
+
+func newClientReadCache(api *providerMeta) *clientReadCache {
+	return &clientReadCache{
+		list: func() (clients []*management.Client, err error) {
+			var page int
+			for {
+				l, err := api.Client.List(management.Page(page), api.perPage())
+				if err != nil {
+					return nil, err
+				}
+				clients = append(clients, l.Clients...)
+				if !l.HasNext() {
+					break
+				}
+				page++
+			}
+			return clients, nil
+		},
+		read: func(id string) (*management.Client, error) {
+			return api.Client.Read(id)
+		},
+	}
+}
+
+// Read returns the client identified by id, populating the cache on first use.
+func (c *clientReadCache) Read(id string) (*management.Client, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.loaded {
+		clients, err := c.list()
+		if err != nil {
+			return nil, err
+		}
+		c.clients = make(map[string]*management.Client, len(clients))
+		for _, client := range clients {
+			c.clients[client.GetClientID()] = client
+		}
+		c.loaded = true
+	}
+
+	if client, ok := c.clients[id]; ok {
+		return client, nil
+	}
+	return c.read(id)
+}
+
+// Evict removes a client from the cache, so that the next read retrieves it
+// from the API. It must be called whenever a client is modified.
+func (c *clientReadCache) Evict(id string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.clients, id)
+}
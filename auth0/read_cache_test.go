@@ -0,0 +1,55 @@
+package auth0
+
+import (
+	"testing"
+
+	"gopkg.in/auth0.v4"
+	"gopkg.in/auth0.v4/management"
+)
+
+func TestClientReadCache(t *testing.T) {
+
+	var lists, reads int
+
+	c := &clientReadCache{
+		list: func() ([]*management.Client, error) {
+			lists++
+			return []*management.Client{
+				{ClientID: auth0.String("foo"), Name: auth0.String("Foo")},
+				{ClientID: auth0.String("bar"), Name: auth0.String("Bar")},
+			}, nil
+		},
+		read: func(id string) (*management.Client, error) {
+			reads++
+			return &management.Client{ClientID: auth0.String(id)}, nil
+		},
+	}
+
+	for _, id := range []string{"foo", "bar", "foo"} {
+		client, err := c.Read(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if client.GetClientID() != id {
+			t.Errorf("Expected client %q, got %q", id, client.GetClientID())
+		}
+	}
+	if lists != 1 || reads != 0 {
+		t.Errorf("Expected cache hits to avoid extra requests, got %d lists and %d reads", lists, reads)
+	}
+
+	if _, err := c.Read("baz"); err != nil {
+		t.Fatal(err)
+	}
+	if lists != 1 || reads != 1 {
+		t.Errorf("Expected a cache miss to read the client, got %d lists and %d reads", lists, reads)
+	}
+
+	c.Evict("foo")
+	if _, err := c.Read("foo"); err != nil {
+		t.Fatal(err)
+	}
+	if lists != 1 || reads != 2 {
+		t.Errorf("Expected an evicted client to be read again, got %d lists and %d reads", lists, reads)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"gopkg.in/auth0.v4"
 	"gopkg.in/auth0.v4/management"
 
+	"github.com/alexkappa/terraform-provider-auth0/auth0/internal/diff"
 	v "github.com/alexkappa/terraform-provider-auth0/auth0/internal/validation"
 )
 
@@ -77,13 +78,19 @@ func newClient() *schema.Resource {
 				Computed: true,
 			},
 			"callbacks": {
-				Type:     schema.TypeList,
-				Elem:     &schema.Schema{Type: schema.TypeString},
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					DiffSuppressFunc: diff.IgnoreURLNormalization,
+				},
 				Optional: true,
 			},
 			"allowed_logout_urls": {
-				Type:     schema.TypeList,
-				Elem:     &schema.Schema{Type: schema.TypeString},
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					DiffSuppressFunc: diff.IgnoreURLNormalization,
+				},
 				Optional: true,
 			},
 			"grant_types": {
@@ -93,15 +100,38 @@ func newClient() *schema.Resource {
 				Optional: true,
 			},
 			"allowed_origins": {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateFunc:     v.IsOrigin,
+					DiffSuppressFunc: diff.IgnoreURLNormalization,
+				},
+				Optional: true,
+			},
+			"web_origins": {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateFunc:     v.IsOrigin,
+					DiffSuppressFunc: diff.IgnoreURLNormalization,
+				},
+				Optional: true,
+			},
+			"client_aliases": {
 				Type:     schema.TypeList,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Optional: true,
 			},
-			"web_origins": {
+			"allowed_clients": {
 				Type:     schema.TypeList,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Optional: true,
 			},
+			"signing_keys": {
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeMap},
+				Computed: true,
+			},
 			"jwt_configuration": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -505,7 +535,7 @@ func newClient() *schema.Resource {
 
 func createClient(d *schema.ResourceData, m interface{}) error {
 	c := expandClient(d)
-	api := m.(*management.Management)
+	api := m.(*providerMeta)
 	if err := api.Client.Create(c); err != nil {
 		return err
 	}
@@ -514,8 +544,8 @@ func createClient(d *schema.ResourceData, m interface{}) error {
 }
 
 func readClient(d *schema.ResourceData, m interface{}) error {
-	api := m.(*management.Management)
-	c, err := api.Client.Read(d.Id())
+	api := m.(*providerMeta)
+	c, err := readClientFromAPI(api, d.Id())
 	if err != nil {
 		if mErr, ok := err.(management.Error); ok {
 			if mErr.Status() == http.StatusNotFound {
@@ -540,6 +570,9 @@ func readClient(d *schema.ResourceData, m interface{}) error {
 	d.Set("allowed_origins", c.AllowedOrigins)
 	d.Set("grant_types", c.GrantTypes)
 	d.Set("web_origins", c.WebOrigins)
+	d.Set("client_aliases", c.ClientAliases)
+	d.Set("allowed_clients", c.AllowedClients)
+	d.Set("signing_keys", c.SigningKeys)
 	d.Set("sso", c.SSO)
 	d.Set("sso_disabled", c.SSODisabled)
 	d.Set("cross_origin_auth", c.CrossOriginAuth)
@@ -562,7 +595,10 @@ func readClient(d *schema.ResourceData, m interface{}) error {
 
 func updateClient(d *schema.ResourceData, m interface{}) error {
 	c := expandClient(d)
-	api := m.(*management.Management)
+	api := m.(*providerMeta)
+	if api.clientReadCache != nil {
+		api.clientReadCache.Evict(d.Id())
+	}
 	if clientHasChange(c) {
 		err := api.Client.Update(d.Id(), c)
 		if err != nil {
@@ -579,7 +615,10 @@ func updateClient(d *schema.ResourceData, m interface{}) error {
 }
 
 func deleteClient(d *schema.ResourceData, m interface{}) error {
-	api := m.(*management.Management)
+	api := m.(*providerMeta)
+	if api.clientReadCache != nil {
+		api.clientReadCache.Evict(d.Id())
+	}
 	err := api.Client.Delete(d.Id())
 	if err != nil {
 		if mErr, ok := err.(management.Error); ok {
@@ -592,6 +631,15 @@ func deleteClient(d *schema.ResourceData, m interface{}) error {
 	return err
 }
 
+// readClientFromAPI reads a client, going through the read cache if the
+// provider has it enabled.
+func readClientFromAPI(api *providerMeta, id string) (*management.Client, error) {
+	if api.clientReadCache != nil {
+		return api.clientReadCache.Read(id)
+	}
+	return api.Client.Read(id)
+}
+
 func expandClient(d *schema.ResourceData) *management.Client {
 
 	c := &management.Client{
@@ -607,6 +655,8 @@ func expandClient(d *schema.ResourceData) *management.Client {
 		AllowedOrigins:                 Slice(d, "allowed_origins"),
 		GrantTypes:                     Slice(d, "grant_types"),
 		WebOrigins:                     Slice(d, "web_origins"),
+		ClientAliases:                  Slice(d, "client_aliases"),
+		AllowedClients:                 Slice(d, "allowed_clients"),
 		SSO:                            Bool(d, "sso"),
 		SSODisabled:                    Bool(d, "sso_disabled"),
 		CrossOriginAuth:                Bool(d, "cross_origin_auth"),
@@ -750,7 +800,7 @@ func buildClientAddon(d map[string]interface{}) map[string]interface{} {
 
 func rotateClientSecret(d *schema.ResourceData, m interface{}) error {
 	if d.HasChange("client_secret_rotation_trigger") {
-		api := m.(*management.Management)
+		api := m.(*providerMeta)
 		c, err := api.Client.RotateSecret(d.Id())
 		if err != nil {
 			return err
@@ -1,7 +1,10 @@
 package auth0
 
 import (
+	"fmt"
 	"net/http"
+	"sort"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
@@ -17,7 +20,7 @@ func newClientGrant() *schema.Resource {
 		Update: updateClientGrant,
 		Delete: deleteClientGrant,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importClientGrant,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -32,7 +35,7 @@ func newClientGrant() *schema.Resource {
 				ForceNew: true,
 			},
 			"scope": {
-				Type:     schema.TypeList,
+				Type:     schema.TypeSet,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Required: true,
 			},
@@ -42,7 +45,7 @@ func newClientGrant() *schema.Resource {
 
 func createClientGrant(d *schema.ResourceData, m interface{}) error {
 	g := buildClientGrant(d)
-	api := m.(*management.Management)
+	api := m.(*providerMeta)
 	if err := api.ClientGrant.Create(g); err != nil {
 		return err
 	}
@@ -50,8 +53,43 @@ func createClientGrant(d *schema.ResourceData, m interface{}) error {
 	return readClientGrant(d, m)
 }
 
+// importClientGrant allows a client grant to be imported either by its ID or
+// by its client ID and audience separated by a double colon.
+func importClientGrant(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "::", 2)
+	if len(parts) != 2 {
+		return []*schema.ResourceData{d}, nil
+	}
+	clientID, audience := parts[0], parts[1]
+
+	api := m.(*providerMeta)
+	var page int
+	for {
+		l, err := api.ClientGrant.List(
+			management.Parameter("client_id", clientID),
+			management.Parameter("audience", audience),
+			management.Page(page),
+			api.perPage())
+		if err != nil {
+			return nil, err
+		}
+		for _, g := range l.ClientGrants {
+			if g.GetClientID() == clientID && g.GetAudience() == audience {
+				d.SetId(g.GetID())
+				return []*schema.ResourceData{d}, nil
+			}
+		}
+		if !l.HasNext() {
+			break
+		}
+		page++
+	}
+
+	return nil, fmt.Errorf("no client grant found for client %q and audience %q", clientID, audience)
+}
+
 func readClientGrant(d *schema.ResourceData, m interface{}) error {
-	api := m.(*management.Management)
+	api := m.(*providerMeta)
 	g, err := api.ClientGrant.Read(d.Id())
 	if err != nil {
 		if mErr, ok := err.(management.Error); ok {
@@ -73,7 +111,7 @@ func updateClientGrant(d *schema.ResourceData, m interface{}) error {
 	g := buildClientGrant(d)
 	g.Audience = nil
 	g.ClientID = nil
-	api := m.(*management.Management)
+	api := m.(*providerMeta)
 	err := api.ClientGrant.Update(d.Id(), g)
 	if err != nil {
 		return err
@@ -82,7 +120,7 @@ func updateClientGrant(d *schema.ResourceData, m interface{}) error {
 }
 
 func deleteClientGrant(d *schema.ResourceData, m interface{}) error {
-	api := m.(*management.Management)
+	api := m.(*providerMeta)
 	err := api.ClientGrant.Delete(d.Id())
 	if err != nil {
 		if mErr, ok := err.(management.Error); ok {
@@ -101,10 +139,12 @@ func buildClientGrant(d *schema.ResourceData) *management.ClientGrant {
 		ClientID: String(d, "client_id"),
 		Audience: String(d, "audience"),
 	}
-	if scope, ok := d.GetOk("scope"); ok {
-		g.Scope = scope.([]interface{})
-	} else {
-		g.Scope = []interface{}{}
-	}
+	// Scopes are sorted so the request is deterministic regardless of the
+	// order in which the set is iterated. An empty scope is still sent, as
+	// it is how all scopes are revoked from the grant.
+	g.Scope = Set(d, "scope").List()
+	sort.Slice(g.Scope, func(i, j int) bool {
+		return g.Scope[i].(string) < g.Scope[j].(string)
+	})
 	return g
 }
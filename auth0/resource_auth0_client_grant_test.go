@@ -1,11 +1,14 @@
 package auth0
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/alexkappa/terraform-provider-auth0/auth0/internal/random"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"gopkg.in/auth0.v4/management"
 )
 
 func TestAccClientGrant(t *testing.T) {
@@ -28,9 +31,47 @@ func TestAccClientGrant(t *testing.T) {
 				Config: random.Template(testAccClientGrantConfigUpdate, rand),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("auth0_client_grant.my_client_grant", "scope.#", "1"),
-					resource.TestCheckResourceAttr("auth0_client_grant.my_client_grant", "scope.0", "create:foo"),
+					testAccCheckTypeSetElemAttr("auth0_client_grant.my_client_grant", "scope", "create:foo"),
 				),
 			},
+			{
+				// A scope granted outside of Terraform must be detected on
+				// refresh, resulting in a plan to remove it.
+				PreConfig: func() {
+					testAccClientGrantSetScope(t, "https://uat.tf.alexkappa.com/client-grant/"+rand, "create:foo", "create:bar")
+				},
+				Config:             random.Template(testAccClientGrantConfigUpdate, rand),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: random.Template(testAccClientGrantConfigUpdateMultiple, rand),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_client_grant.my_client_grant", "scope.#", "2"),
+					testAccCheckTypeSetElemAttr("auth0_client_grant.my_client_grant", "scope", "create:foo"),
+					testAccCheckTypeSetElemAttr("auth0_client_grant.my_client_grant", "scope", "create:bar"),
+				),
+			},
+			{
+				// Reordering the scopes must not produce a diff.
+				Config:             random.Template(testAccClientGrantConfigUpdateMultipleReordered, rand),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+			{
+				ResourceName:      "auth0_client_grant.my_client_grant",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName: "auth0_client_grant.my_client_grant",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["auth0_client_grant.my_client_grant"]
+					return rs.Primary.Attributes["client_id"] + "::" + rs.Primary.Attributes["audience"], nil
+				},
+				ImportStateVerify: true,
+			},
 			{
 				Config: random.Template(testAccClientGrantConfigUpdateAgain, rand),
 				Check: resource.ComposeTestCheckFunc(
@@ -47,6 +88,44 @@ func TestAccClientGrant(t *testing.T) {
 	})
 }
 
+// testAccCheckTypeSetElemAttr checks that the set held by key contains value,
+// without depending on the hash of the element. The plugin SDK in use has no
+// TestCheckTypeSetElemAttr.
+func testAccCheckTypeSetElemAttr(name, key, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+		for k, v := range rs.Primary.Attributes {
+			if strings.HasPrefix(k, key+".") && k != key+".#" && v == value {
+				return nil
+			}
+		}
+		return fmt.Errorf("%s: no element of %s is %q", name, key, value)
+	}
+}
+
+// testAccClientGrantSetScope replaces the scope of the client grant for the
+// given audience, simulating a change made outside of Terraform.
+func testAccClientGrantSetScope(t *testing.T, audience string, scope ...interface{}) {
+	api, err := Auth0()
+	if err != nil {
+		t.Fatal(err)
+	}
+	l, err := api.ClientGrant.List(management.Parameter("audience", audience))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(l.ClientGrants) != 1 {
+		t.Fatalf("Expected 1 client grant for audience %q, found %d", audience, len(l.ClientGrants))
+	}
+	err = api.ClientGrant.Update(l.ClientGrants[0].GetID(), &management.ClientGrant{Scope: scope})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
 const testAccClientGrantAuxConfig = `
 
 resource "auth0_client" "my_client" {
@@ -87,6 +166,24 @@ resource "auth0_client_grant" "my_client_grant" {
 }
 `
 
+const testAccClientGrantConfigUpdateMultiple = testAccClientGrantAuxConfig + `
+
+resource "auth0_client_grant" "my_client_grant" {
+	client_id = "${auth0_client.my_client.id}"
+	audience = "${auth0_resource_server.my_resource_server.identifier}"
+	scope = [ "create:foo", "create:bar" ]
+}
+`
+
+const testAccClientGrantConfigUpdateMultipleReordered = testAccClientGrantAuxConfig + `
+
+resource "auth0_client_grant" "my_client_grant" {
+	client_id = "${auth0_client.my_client.id}"
+	audience = "${auth0_resource_server.my_resource_server.identifier}"
+	scope = [ "create:bar", "create:foo" ]
+}
+`
+
 const testAccClientGrantConfigUpdateAgain = testAccClientGrantAuxConfig + `
 
 resource "auth0_client_grant" "my_client_grant" {
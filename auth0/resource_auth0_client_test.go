@@ -77,6 +77,14 @@ func TestAccClient(t *testing.T) {
 					resource.TestCheckResourceAttr("auth0_client.my_client", "addons.0.samlp.0.name_identifier_format", "urn:oasis:names:tc:SAML:2.0:nameid-format:persistent"),
 					resource.TestCheckResourceAttr("auth0_client.my_client", "client_metadata.foo", "zoo"),
 					resource.TestCheckResourceAttr("auth0_client.my_client", "initiate_login_uri", "https://example.com/login"),
+					resource.TestCheckResourceAttr("auth0_client.my_client", "client_aliases.#", "1"),
+					resource.TestCheckResourceAttr("auth0_client.my_client", "client_aliases.0", "https://alias.example.com"),
+					resource.TestCheckResourceAttr("auth0_client.my_client", "allowed_clients.#", "1"),
+					resource.TestCheckResourceAttrPair("auth0_client.my_client", "allowed_clients.0", "auth0_client.allowed_client", "client_id"),
+					resource.TestCheckResourceAttr("auth0_client.my_client", "signing_keys.#", "1"),
+					resource.TestCheckResourceAttrSet("auth0_client.my_client", "signing_keys.0.cert"),
+					resource.TestCheckResourceAttrSet("auth0_client.my_client", "signing_keys.0.pkcs7"),
+					resource.TestCheckResourceAttrSet("auth0_client.my_client", "signing_keys.0.subject"),
 				),
 			},
 		},
@@ -85,6 +93,11 @@ func TestAccClient(t *testing.T) {
 
 const testAccClientConfig = `
 
+resource "auth0_client" "allowed_client" {
+  name = "Acceptance Test - Allowed - {{.random}}"
+  app_type = "non_interactive"
+}
+
 resource "auth0_client" "my_client" {
   name = "Acceptance Test - {{.random}}"
   description = "Test Application Long Description"
@@ -99,6 +112,8 @@ resource "auth0_client" "my_client" {
   grant_types = [ "authorization_code", "http://auth0.com/oauth/grant-type/password-realm", "implicit", "password", "refresh_token" ]
   allowed_logout_urls = [ "https://example.com" ]
   web_origins = [ "https://example.com" ]
+  client_aliases = [ "https://alias.example.com" ]
+  allowed_clients = [ "${auth0_client.allowed_client.client_id}" ]
   jwt_configuration {
     lifetime_in_seconds = 300
     secret_encoded = true
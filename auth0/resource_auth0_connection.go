@@ -1,15 +1,19 @@
 package auth0
 
 import (
+	"fmt"
 	"log"
 	"net/http"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 
 	"gopkg.in/auth0.v4"
 	"gopkg.in/auth0.v4/management"
+
+	"github.com/alexkappa/terraform-provider-auth0/auth0/internal/diff"
 )
 
 func newConnection() *schema.Resource {
@@ -22,6 +26,7 @@ func newConnection() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: validateConnectionRealms,
 		Schema:        connectionSchema,
 		SchemaVersion: 1,
 		StateUpgraders: []schema.StateUpgrader{
@@ -160,6 +165,25 @@ var connectionSchema = map[string]*schema.Schema{
 					},
 					Description: "Configuration settings for password complexity",
 				},
+				"mfa": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Computed: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"active": {
+								Type:     schema.TypeBool,
+								Optional: true,
+							},
+							"return_enroll_settings": {
+								Type:     schema.TypeBool,
+								Optional: true,
+							},
+						},
+					},
+					Description: "Configuration settings for multi-factor authentication on the connection",
+				},
 				"enabled_database_customization": {
 					Type:        schema.TypeBool,
 					Optional:    true,
@@ -186,10 +210,11 @@ var connectionSchema = map[string]*schema.Schema{
 					Description: "Indicates whether or not the user is required to provide a username in addition to an email address",
 				},
 				"custom_scripts": {
-					Type:        schema.TypeMap,
-					Elem:        &schema.Schema{Type: schema.TypeString},
-					Optional:    true,
-					Description: "",
+					Type:             schema.TypeMap,
+					Elem:             &schema.Schema{Type: schema.TypeString},
+					Optional:         true,
+					DiffSuppressFunc: diff.IgnoreScriptFormatting,
+					Description:      "Custom database action scripts, keyed by action (e.g. login, get_user, create, verify, change_password, delete)",
 				},
 				"scripts": {
 					Type:        schema.TypeMap,
@@ -273,9 +298,10 @@ var connectionSchema = map[string]*schema.Schema{
 					Description: "",
 				},
 				"icon_url": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "",
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.IsURLWithHTTPS,
+					Description:  "Icon displayed on the login screen for this connection. Must be an https URL",
 				},
 				"identity_api": {
 					Type:        schema.TypeString,
@@ -380,6 +406,15 @@ var connectionSchema = map[string]*schema.Schema{
 					Type:     schema.TypeString,
 					Optional: true,
 				},
+				"set_user_root_attributes": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"on_each_login", "on_first_login",
+					}, false),
+					Description: "Determines whether the user's root attributes are updated at each login or only at the first one",
+				},
 
 				// salesforce options
 				"community_base_url": {
@@ -401,9 +436,12 @@ var connectionSchema = map[string]*schema.Schema{
 
 				// OIDC options
 				"type": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "",
+					Type:     schema.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"back_channel", "front_channel",
+					}, false),
+					Description: "Value can be `back_channel` or `front_channel`",
 				},
 				"issuer": {
 					Type:        schema.TypeString,
@@ -456,9 +494,10 @@ var connectionSchema = map[string]*schema.Schema{
 					}, true),
 				},
 				"request_template": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Template that formats the SAML request.",
+					Type:             schema.TypeString,
+					Optional:         true,
+					DiffSuppressFunc: diff.IgnoreWhitespace,
+					Description:      "Template that formats the SAML request.",
 				},
 				"user_id_attribute": {
 					Type:        schema.TypeString,
@@ -509,13 +548,21 @@ var connectionSchema = map[string]*schema.Schema{
 					Description: "When enabled, the SAML authentication request will be signed.",
 				},
 				"signature_algorithm": {
-					Type:        schema.TypeString,
-					Optional:    true,
+					Type:     schema.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"rsa-sha256",
+						"rsa-sha1",
+					}, false),
 					Description: "Sign Request Algorithm",
 				},
 				"digest_algorithm": {
-					Type:        schema.TypeString,
-					Optional:    true,
+					Type:     schema.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"sha256",
+						"sha1",
+					}, false),
 					Description: "Sign Request Algorithm Digest",
 				},
 			},
@@ -530,12 +577,28 @@ var connectionSchema = map[string]*schema.Schema{
 		Description: "IDs of the clients for which the connection is enabled",
 	},
 	"realms": {
-		Type:        schema.TypeList,
-		Elem:        &schema.Schema{Type: schema.TypeString},
+		Type: schema.TypeList,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.NoZeroValues,
+		},
 		Optional:    true,
 		Computed:    true,
 		Description: "Defines the realms for which the connection will be used (i.e., email domains). If not specified, the connection name is added as the realm",
 	},
+	"metadata": {
+		Type:        schema.TypeMap,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "Metadata associated with the connection, in the form of a map of string values (max 255 chars). Maximum of 10 metadata properties allowed",
+	},
+	"options_json": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateFunc:     validation.ValidateJsonString,
+		DiffSuppressFunc: structure.SuppressJsonDiff,
+		Description:      "Raw JSON object merged into the connection options, for options not modeled by the provider. Options set in the options block take precedence",
+	},
 }
 
 func connectionSchemaV0() *schema.Resource {
@@ -585,9 +648,32 @@ func connectionSchemaUpgradeV0(state map[string]interface{}, meta interface{}) (
 	return state, nil
 }
 
+// validateConnectionRealms ensures realms are unique, as they are used for home
+// realm discovery and a realm can only route to a single connection.
+func validateConnectionRealms(d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("realms") {
+		return nil
+	}
+	seen := make(map[string]bool)
+	for _, realm := range d.Get("realms").([]interface{}) {
+		r, _ := realm.(string)
+		if r == "" {
+			continue
+		}
+		if seen[r] {
+			return fmt.Errorf("realms must be unique, %q is defined more than once", r)
+		}
+		seen[r] = true
+	}
+	return nil
+}
+
 func createConnection(d *schema.ResourceData, m interface{}) error {
 	c := expandConnection(d)
-	api := m.(*management.Management)
+	if err := expandConnectionOptionsJSON(d, c); err != nil {
+		return err
+	}
+	api := m.(*providerMeta)
 	if err := api.Connection.Create(c); err != nil {
 		return err
 	}
@@ -596,7 +682,7 @@ func createConnection(d *schema.ResourceData, m interface{}) error {
 }
 
 func readConnection(d *schema.ResourceData, m interface{}) error {
-	api := m.(*management.Management)
+	api := m.(*providerMeta)
 	c, err := api.Connection.Read(d.Id())
 	if err != nil {
 		if mErr, ok := err.(management.Error); ok {
@@ -610,17 +696,27 @@ func readConnection(d *schema.ResourceData, m interface{}) error {
 
 	d.SetId(auth0.StringValue(c.ID))
 	d.Set("name", c.Name)
+	d.Set("display_name", c.DisplayName)
 	d.Set("is_domain_connection", c.IsDomainConnection)
 	d.Set("strategy", c.Strategy)
-	d.Set("options", flattenConnectionOptions(d, c.Options))
+	d.Set("options", flattenConnectionOptions(d, c))
 	d.Set("enabled_clients", c.EnabledClients)
 	d.Set("realms", c.Realms)
+
+	var metadata interface{}
+	if c.Metadata != nil {
+		metadata = *c.Metadata
+	}
+	d.Set("metadata", metadata)
 	return nil
 }
 
 func updateConnection(d *schema.ResourceData, m interface{}) error {
 	c := expandConnection(d)
-	api := m.(*management.Management)
+	if err := expandConnectionOptionsJSON(d, c); err != nil {
+		return err
+	}
+	api := m.(*providerMeta)
 	err := api.Connection.Update(d.Id(), c)
 	if err != nil {
 		return err
@@ -629,7 +725,10 @@ func updateConnection(d *schema.ResourceData, m interface{}) error {
 }
 
 func deleteConnection(d *schema.ResourceData, m interface{}) error {
-	api := m.(*management.Management)
+	api := m.(*providerMeta)
+	if d.Get("strategy").(string) == management.ConnectionStrategyAuth0 {
+		warnConnectionHasUsers(api, d.Get("name").(string))
+	}
 	err := api.Connection.Delete(d.Id())
 	if err != nil {
 		if mErr, ok := err.(management.Error); ok {
@@ -641,3 +740,21 @@ func deleteConnection(d *schema.ResourceData, m interface{}) error {
 	}
 	return err
 }
+
+// warnConnectionHasUsers logs a warning if the database connection about to be
+// deleted still has users, as Auth0 deletes them together with the connection.
+func warnConnectionHasUsers(api *providerMeta, name string) {
+	l, err := api.User.List(
+		management.Parameter("q", fmt.Sprintf("identities.connection:%q", name)),
+		management.Parameter("search_engine", "v3"),
+		management.PerPage(1),
+		management.WithFields("user_id"),
+	)
+	if err != nil {
+		log.Printf("[DEBUG] Unable to determine whether connection %s has users: %s", name, err)
+		return
+	}
+	if len(l.Users) > 0 {
+		log.Printf("[WARN] Connection %s still has users, they will be deleted together with the connection", name)
+	}
+}
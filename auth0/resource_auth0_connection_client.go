@@ -0,0 +1,148 @@
+package auth0
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"gopkg.in/auth0.v4/management"
+)
+
+func newConnectionClient() *schema.Resource {
+	return &schema.Resource{
+
+		Create: createConnectionClient,
+		Read:   readConnectionClient,
+		Delete: deleteConnectionClient,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"connection_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the connection on which to enable the client",
+			},
+			"client_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the client for which the connection is enabled",
+			},
+		},
+	}
+}
+
+func createConnectionClient(d *schema.ResourceData, m interface{}) error {
+	connectionID := d.Get("connection_id").(string)
+	clientID := d.Get("client_id").(string)
+
+	globalMutexKV.Lock(connectionID)
+	defer globalMutexKV.Unlock(connectionID)
+
+	api := m.(*providerMeta)
+	c, err := api.Connection.Read(connectionID)
+	if err != nil {
+		return err
+	}
+
+	if !containsString(c.EnabledClients, clientID) {
+		err = api.Connection.Update(connectionID, &management.Connection{
+			EnabledClients: append(c.EnabledClients, clientID),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	d.SetId(connectionClientID(connectionID, clientID))
+	return readConnectionClient(d, m)
+}
+
+func readConnectionClient(d *schema.ResourceData, m interface{}) error {
+	connectionID, clientID, err := parseConnectionClientID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	api := m.(*providerMeta)
+	c, err := api.Connection.Read(connectionID)
+	if err != nil {
+		if mErr, ok := err.(management.Error); ok {
+			if mErr.Status() == http.StatusNotFound {
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	// The client has been disabled on the connection outside of Terraform.
+	if !containsString(c.EnabledClients, clientID) {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("connection_id", connectionID)
+	d.Set("client_id", clientID)
+	return nil
+}
+
+func deleteConnectionClient(d *schema.ResourceData, m interface{}) error {
+	connectionID := d.Get("connection_id").(string)
+	clientID := d.Get("client_id").(string)
+
+	globalMutexKV.Lock(connectionID)
+	defer globalMutexKV.Unlock(connectionID)
+
+	api := m.(*providerMeta)
+	c, err := api.Connection.Read(connectionID)
+	if err != nil {
+		if mErr, ok := err.(management.Error); ok {
+			if mErr.Status() == http.StatusNotFound {
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	enabledClients := make([]interface{}, 0, len(c.EnabledClients))
+	for _, id := range c.EnabledClients {
+		if id != clientID {
+			enabledClients = append(enabledClients, id)
+		}
+	}
+
+	if len(enabledClients) != len(c.EnabledClients) {
+		err = api.Connection.Update(connectionID, &management.Connection{
+			EnabledClients: enabledClients,
+		})
+	}
+	return err
+}
+
+func connectionClientID(connectionID, clientID string) string {
+	return connectionID + ":" + clientID
+}
+
+func parseConnectionClientID(id string) (connectionID, clientID string, err error) {
+	parts := strings.SplitN(id, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%q), expected <connection_id>:<client_id>", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+func containsString(list []interface{}, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
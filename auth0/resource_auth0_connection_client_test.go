@@ -0,0 +1,134 @@
+package auth0
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/alexkappa/terraform-provider-auth0/auth0/internal/random"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccConnectionClient(t *testing.T) {
+
+	rand := random.String(6)
+
+	resource.Test(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"auth0": Provider(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: random.Template(testAccConnectionClientConfig, rand),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("auth0_connection_client.first", "connection_id", "auth0_connection.my_connection", "id"),
+					resource.TestCheckResourceAttrPair("auth0_connection_client.first", "client_id", "auth0_client.first", "id"),
+					resource.TestCheckResourceAttrPair("auth0_connection_client.second", "connection_id", "auth0_connection.my_connection", "id"),
+					resource.TestCheckResourceAttrPair("auth0_connection_client.second", "client_id", "auth0_client.second", "id"),
+				),
+			},
+			{
+				// Refresh the connection now that both enablements have been
+				// applied concurrently and check neither was overwritten.
+				Config: random.Template(testAccConnectionClientConfig, rand),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_connection.my_connection", "enabled_clients.#", "2"),
+				),
+			},
+			{
+				// Update the connection while the enablements exist. The
+				// connection mustn't send back the enabled clients it read
+				// earlier, which would overwrite the enablements.
+				Config: random.Template(testAccConnectionClientConfigUpdate, rand),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_connection.my_connection", "options.0.password_policy", "good"),
+					testAccCheckConnectionEnabledClientCount("auth0_connection.my_connection", 2),
+				),
+			},
+			{
+				ResourceName:      "auth0_connection_client.first",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccConnectionClientConfig = testAccConnectionClientConfigClients + `
+
+resource "auth0_connection" "my_connection" {
+	name = "Acceptance-Test-Connection-Client-{{.random}}"
+	strategy = "auth0"
+}
+`
+
+const testAccConnectionClientConfigUpdate = testAccConnectionClientConfigClients + `
+
+resource "auth0_connection" "my_connection" {
+	name = "Acceptance-Test-Connection-Client-{{.random}}"
+	strategy = "auth0"
+	options {
+		password_policy = "good"
+	}
+}
+`
+
+const testAccConnectionClientConfigClients = `
+
+resource "auth0_client" "first" {
+	name = "Acceptance Test - Connection Client 1 - {{.random}}"
+}
+
+resource "auth0_client" "second" {
+	name = "Acceptance Test - Connection Client 2 - {{.random}}"
+}
+
+resource "auth0_connection_client" "first" {
+	connection_id = "${auth0_connection.my_connection.id}"
+	client_id = "${auth0_client.first.id}"
+}
+
+resource "auth0_connection_client" "second" {
+	connection_id = "${auth0_connection.my_connection.id}"
+	client_id = "${auth0_client.second.id}"
+}
+`
+
+func TestParseConnectionClientID(t *testing.T) {
+	connectionID, clientID, err := parseConnectionClientID(connectionClientID("con_123", "abc"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if connectionID != "con_123" || clientID != "abc" {
+		t.Errorf("Unexpected result %q, %q", connectionID, clientID)
+	}
+
+	for _, id := range []string{"", "con_123", "con_123:", ":abc"} {
+		if _, _, err := parseConnectionClientID(id); err == nil {
+			t.Errorf("Expected an error parsing %q", id)
+		}
+	}
+}
+
+// testAccCheckConnectionEnabledClientCount reads the connection through the
+// Management API, as its state may still hold the enabled clients it sent.
+func testAccCheckConnectionEnabledClientCount(name string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+		api, err := Auth0()
+		if err != nil {
+			return err
+		}
+		c, err := api.Connection.Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if len(c.EnabledClients) != expected {
+			return fmt.Errorf("Expected %d enabled clients, got %d", expected, len(c.EnabledClients))
+		}
+		return nil
+	}
+}
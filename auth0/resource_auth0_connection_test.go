@@ -1,14 +1,21 @@
 package auth0
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
 	"log"
+	"net/http"
+	"os"
 	"reflect"
+	"regexp"
 	"strings"
 	"testing"
 
 	"github.com/alexkappa/terraform-provider-auth0/auth0/internal/random"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 	"gopkg.in/auth0.v4/management"
 )
@@ -69,6 +76,8 @@ func TestAccConnection(t *testing.T) {
 					resource.TestCheckResourceAttr("auth0_connection.my_connection", "options.0.password_no_personal_info.0.enable", "true"),
 					resource.TestCheckResourceAttr("auth0_connection.my_connection", "options.0.password_dictionary.0.enable", "true"),
 					resource.TestCheckResourceAttr("auth0_connection.my_connection", "options.0.password_complexity_options.0.min_length", "6"),
+					resource.TestCheckResourceAttr("auth0_connection.my_connection", "options.0.mfa.0.active", "true"),
+					resource.TestCheckResourceAttr("auth0_connection.my_connection", "options.0.mfa.0.return_enroll_settings", "true"),
 					resource.TestCheckResourceAttr("auth0_connection.my_connection", "options.0.enabled_database_customization", "false"),
 					resource.TestCheckResourceAttr("auth0_connection.my_connection", "options.0.brute_force_protection", "true"),
 					resource.TestCheckResourceAttr("auth0_connection.my_connection", "options.0.import_mode", "false"),
@@ -76,12 +85,15 @@ func TestAccConnection(t *testing.T) {
 					resource.TestCheckResourceAttr("auth0_connection.my_connection", "options.0.requires_username", "true"),
 					resource.TestCheckResourceAttr("auth0_connection.my_connection", "options.0.custom_scripts.get_user", "myFunction"),
 					resource.TestCheckResourceAttrSet("auth0_connection.my_connection", "options.0.configuration.foo"),
+					resource.TestCheckResourceAttr("auth0_connection.my_connection", "options.0.strategy_version", "2"),
 				),
 			},
 			{
 				Config: random.Template(testAccConnectionConfigUpdate, rand),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("auth0_connection.my_connection", "options.0.brute_force_protection", "false"),
+					resource.TestCheckResourceAttr("auth0_connection.my_connection", "options.0.mfa.0.active", "true"),
+					resource.TestCheckResourceAttr("auth0_connection.my_connection", "options.0.mfa.0.return_enroll_settings", "false"),
 				),
 			},
 		},
@@ -110,6 +122,10 @@ resource "auth0_connection" "my_connection" {
 		password_complexity_options {
 			min_length = 6
 		}
+		mfa {
+			active = true
+			return_enroll_settings = true
+		}
 		enabled_database_customization = false
 		brute_force_protection = true
 		import_mode = false
@@ -121,6 +137,7 @@ resource "auth0_connection" "my_connection" {
 		configuration = {
 			foo = "bar"
 		}
+		strategy_version = 2
 	}
 }
 `
@@ -140,6 +157,10 @@ resource "auth0_connection" "my_connection" {
 		password_no_personal_info {
 			enable = true
 		}
+		mfa {
+			active = true
+			return_enroll_settings = false
+		}
 		enabled_database_customization = false
 		brute_force_protection = false
 		import_mode = false
@@ -155,6 +176,91 @@ resource "auth0_connection" "my_connection" {
 }
 `
 
+func TestAccConnectionCustomDatabase(t *testing.T) {
+
+	rand := random.String(6)
+
+	resource.Test(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"auth0": Provider(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: random.Template(testAccConnectionCustomDatabaseConfig, rand),
+				Check: resource.ComposeTestCheckFunc(
+					random.TestCheckResourceAttr("auth0_connection.custom_database", "name", "Acceptance-Test-Custom-Database-{{.random}}", rand),
+					resource.TestCheckResourceAttr("auth0_connection.custom_database", "options.0.enabled_database_customization", "true"),
+					resource.TestCheckResourceAttr("auth0_connection.custom_database", "options.0.import_mode", "true"),
+					resource.TestCheckResourceAttr("auth0_connection.custom_database", "options.0.disable_signup", "true"),
+					resource.TestCheckResourceAttr("auth0_connection.custom_database", "options.0.requires_username", "false"),
+					resource.TestCheckResourceAttrSet("auth0_connection.custom_database", "options.0.custom_scripts.login"),
+					resource.TestCheckResourceAttrSet("auth0_connection.custom_database", "options.0.custom_scripts.get_user"),
+				),
+			},
+			{
+				// Formatting-only changes to the scripts must not produce a diff.
+				Config:             random.Template(testAccConnectionCustomDatabaseConfigReformatted, rand),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+		},
+	})
+}
+
+const testAccConnectionCustomDatabaseConfig = `
+
+resource "auth0_connection" "custom_database" {
+	name = "Acceptance-Test-Custom-Database-{{.random}}"
+	strategy = "auth0"
+	options {
+		enabled_database_customization = true
+		import_mode = true
+		disable_signup = true
+		requires_username = false
+		custom_scripts = {
+			login = <<EOF
+function login(email, password, callback) {
+  return callback(new Error("Whoops!"));
+}
+EOF
+			get_user = <<EOF
+function getByEmail(email, callback) {
+  return callback(new Error("Whoops!"));
+}
+EOF
+		}
+	}
+}
+`
+
+const testAccConnectionCustomDatabaseConfigReformatted = `
+
+resource "auth0_connection" "custom_database" {
+	name = "Acceptance-Test-Custom-Database-{{.random}}"
+	strategy = "auth0"
+	options {
+		enabled_database_customization = true
+		import_mode = true
+		disable_signup = true
+		requires_username = false
+		custom_scripts = {
+			login = <<EOF
+
+function login(email, password, callback) {
+  return callback(new Error("Whoops!"));
+}
+
+EOF
+			get_user = <<EOF
+function getByEmail(email, callback) {
+  return callback(new Error("Whoops!"));
+}
+EOF
+		}
+	}
+}
+`
+
 func TestAccConnectionAD(t *testing.T) {
 
 	rand := random.String(6)
@@ -256,6 +362,53 @@ resource "auth0_connection" "azure_ad" {
 }
 `
 
+func TestAccConnectionADFS(t *testing.T) {
+
+	rand := random.String(6)
+
+	resource.Test(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"auth0": Provider(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: random.Template(testAccConnectionADFSConfig, rand),
+				Check: resource.ComposeTestCheckFunc(
+					random.TestCheckResourceAttr("auth0_connection.adfs", "name", "Acceptance-Test-ADFS-{{.random}}", rand),
+					resource.TestCheckResourceAttr("auth0_connection.adfs", "strategy", "adfs"),
+					resource.TestCheckResourceAttr("auth0_connection.adfs", "options.0.adfs_server", "https://adfs.example.com/FederationMetadata/2007-06/FederationMetadata.xml"),
+					resource.TestCheckResourceAttr("auth0_connection.adfs", "options.0.tenant_domain", "example.com"),
+					resource.TestCheckResourceAttr("auth0_connection.adfs", "options.0.domain_aliases.#", "2"),
+					resource.TestCheckResourceAttr("auth0_connection.adfs", "options.0.domain_aliases.3506632655", "example.com"),
+					resource.TestCheckResourceAttr("auth0_connection.adfs", "options.0.domain_aliases.3154807651", "api.example.com"),
+					resource.TestCheckResourceAttr("auth0_connection.adfs", "options.0.icon_url", "https://example.com/logo.svg"),
+					resource.TestCheckResourceAttr("auth0_connection.adfs", "options.0.api_enable_users", "true"),
+					resource.TestCheckResourceAttr("auth0_connection.adfs", "options.0.set_user_root_attributes", "on_first_login"),
+				),
+			},
+		},
+	})
+}
+
+const testAccConnectionADFSConfig = `
+
+resource "auth0_connection" "adfs" {
+	name     = "Acceptance-Test-ADFS-{{.random}}"
+	strategy = "adfs"
+	options {
+		adfs_server   = "https://adfs.example.com/FederationMetadata/2007-06/FederationMetadata.xml"
+		tenant_domain = "example.com"
+		domain_aliases = [
+			"example.com",
+			"api.example.com"
+		]
+		icon_url                 = "https://example.com/logo.svg"
+		api_enable_users         = true
+		set_user_root_attributes = "on_first_login"
+	}
+}
+`
+
 func TestAccConnectionOIDC(t *testing.T) {
 
 	rand := random.String(6)
@@ -286,6 +439,8 @@ func TestAccConnectionOIDC(t *testing.T) {
 					resource.TestCheckResourceAttr("auth0_connection.oidc", "options.0.scopes.2517049750", "openid"),
 					resource.TestCheckResourceAttr("auth0_connection.oidc", "options.0.scopes.4080487570", "profile"),
 					resource.TestCheckResourceAttr("auth0_connection.oidc", "options.0.scopes.881205744", "email"),
+					resource.TestCheckResourceAttr("auth0_connection.oidc", "options.0.icon_url", "https://example.com/logo.svg"),
+					resource.TestCheckResourceAttr("auth0_connection.oidc", "display_name", "Yahoo"),
 				),
 			},
 			{
@@ -315,10 +470,12 @@ const testAccConnectionOIDCConfig = `
 
 resource "auth0_connection" "oidc" {
 	name     = "Acceptance-Test-OIDC-{{.random}}"
+	display_name = "Yahoo"
 	strategy = "oidc"
 	options {
 		client_id     = "123456"
 		client_secret = "123456"
+		icon_url      = "https://example.com/logo.svg"
 		domain_aliases = [
 			"example.com",
 			"api.example.com"
@@ -358,6 +515,49 @@ resource "auth0_connection" "oidc" {
 }
 `
 
+func TestAccConnectionOIDCDiscovery(t *testing.T) {
+
+	rand := random.String(6)
+
+	resource.Test(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"auth0": Provider(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: random.Template(testAccConnectionOIDCDiscoveryConfig, rand),
+				Check: resource.ComposeTestCheckFunc(
+					random.TestCheckResourceAttr("auth0_connection.oidc", "name", "Acceptance-Test-OIDC-Discovery-{{.random}}", rand),
+					resource.TestCheckResourceAttr("auth0_connection.oidc", "strategy", "oidc"),
+					resource.TestCheckResourceAttr("auth0_connection.oidc", "options.0.client_id", "123456"),
+					resource.TestCheckResourceAttr("auth0_connection.oidc", "options.0.client_secret", "123456"),
+					resource.TestCheckResourceAttr("auth0_connection.oidc", "options.0.type", "front_channel"),
+					resource.TestCheckResourceAttr("auth0_connection.oidc", "options.0.discovery_url", "https://api.login.yahoo.com/.well-known/openid-configuration"),
+					resource.TestCheckResourceAttr("auth0_connection.oidc", "options.0.scopes.#", "2"),
+					resource.TestCheckResourceAttr("auth0_connection.oidc", "options.0.scopes.2517049750", "openid"),
+					resource.TestCheckResourceAttr("auth0_connection.oidc", "options.0.scopes.881205744", "email"),
+				),
+			},
+		},
+	})
+}
+
+const testAccConnectionOIDCDiscoveryConfig = `
+
+resource "auth0_connection" "oidc" {
+	name     = "Acceptance-Test-OIDC-Discovery-{{.random}}"
+	strategy = "oidc"
+	options {
+		client_id     = "123456"
+		client_secret = "123456"
+		type          = "front_channel"
+		discovery_url = "https://api.login.yahoo.com/.well-known/openid-configuration"
+		issuer        = "https://api.login.yahoo.com"
+		scopes        = [ "openid", "email" ]
+	}
+}
+`
+
 func TestAccConnectionOAuth2(t *testing.T) {
 
 	rand := random.String(6)
@@ -454,11 +654,24 @@ func TestAccConnectionWithEnbledClients(t *testing.T) {
 					resource.TestCheckResourceAttr("auth0_connection.my_connection", "enabled_clients.#", "4"),
 				),
 			},
+			{
+				// The API doesn't preserve the order of enabled clients, so
+				// reordering them in the configuration must not produce a diff.
+				Config:             random.Template(testAccConnectionWithEnabledClientsConfigReordered, rand),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+			{
+				Config: random.Template(testAccConnectionWithEnabledClientsConfigRemoveOne, rand),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_connection.my_connection", "enabled_clients.#", "3"),
+				),
+			},
 		},
 	})
 }
 
-const testAccConnectionWithEnabledClientsConfig = `
+const testAccConnectionWithEnabledClientsAux = `
 
 resource "auth0_client" "my_client_1" {
 	name = "Application - Acceptance Test - 1 - {{.random}}"
@@ -484,6 +697,10 @@ resource "auth0_client" "my_client_4" {
 	app_type = "non_interactive"
 }
 
+`
+
+const testAccConnectionWithEnabledClientsConfig = testAccConnectionWithEnabledClientsAux + `
+
 resource "auth0_connection" "my_connection" {
 	name = "Acceptance-Test-Connection-{{.random}}"
 	is_domain_connection = true
@@ -497,6 +714,35 @@ resource "auth0_connection" "my_connection" {
 }
 `
 
+const testAccConnectionWithEnabledClientsConfigReordered = testAccConnectionWithEnabledClientsAux + `
+
+resource "auth0_connection" "my_connection" {
+	name = "Acceptance-Test-Connection-{{.random}}"
+	is_domain_connection = true
+	strategy = "auth0"
+	enabled_clients = [
+		"${auth0_client.my_client_4.id}",
+		"${auth0_client.my_client_2.id}",
+		"${auth0_client.my_client_1.id}",
+		"${auth0_client.my_client_3.id}",
+	]
+}
+`
+
+const testAccConnectionWithEnabledClientsConfigRemoveOne = testAccConnectionWithEnabledClientsAux + `
+
+resource "auth0_connection" "my_connection" {
+	name = "Acceptance-Test-Connection-{{.random}}"
+	is_domain_connection = true
+	strategy = "auth0"
+	enabled_clients = [
+		"${auth0_client.my_client_1.id}",
+		"${auth0_client.my_client_2.id}",
+		"${auth0_client.my_client_4.id}",
+	]
+}
+`
+
 func TestAccConnectionSMS(t *testing.T) {
 
 	rand := random.String(6)
@@ -564,6 +810,9 @@ func TestAccConnectionEmail(t *testing.T) {
 					resource.TestCheckResourceAttr("auth0_connection.email", "strategy", "email"),
 					resource.TestCheckResourceAttr("auth0_connection.email", "options.0.from", "Magic Password <password@example.com>"),
 					resource.TestCheckResourceAttr("auth0_connection.email", "options.0.subject", "Sign in!"),
+					resource.TestCheckResourceAttr("auth0_connection.email", "options.0.syntax", "liquid"),
+					resource.TestCheckResourceAttr("auth0_connection.email", "options.0.template", "<html><body><h1>Here's your password!</h1></body></html>"),
+					resource.TestCheckResourceAttr("auth0_connection.email", "options.0.brute_force_protection", "true"),
 					resource.TestCheckResourceAttr("auth0_connection.email", "options.0.totp.#", "1"),
 					resource.TestCheckResourceAttr("auth0_connection.email", "options.0.totp.0.time_step", "300"),
 					resource.TestCheckResourceAttr("auth0_connection.email", "options.0.totp.0.length", "6"),
@@ -690,6 +939,7 @@ func TestAccConnectionGoogleOAuth2(t *testing.T) {
 					resource.TestCheckResourceAttr("auth0_connection.google_oauth2", "options.0.scopes.#", "4"),
 					resource.TestCheckResourceAttr("auth0_connection.google_oauth2", "options.0.scopes.881205744", "email"),
 					resource.TestCheckResourceAttr("auth0_connection.google_oauth2", "options.0.scopes.4080487570", "profile"),
+					resource.TestCheckResourceAttr("auth0_connection.google_oauth2", "options.0.icon_url", "https://example.com/logo.svg"),
 				),
 			},
 		},
@@ -707,6 +957,7 @@ resource "auth0_connection" "google_oauth2" {
 		client_secret = ""
 		allowed_audiences = [ "example.com", "api.example.com" ]
 		scopes = [ "email", "profile", "gmail", "youtube" ]
+		icon_url = "https://example.com/logo.svg"
 	}
 }
 `
@@ -947,6 +1198,16 @@ func TestAccConnectionGitHub(t *testing.T) {
 					resource.TestCheckResourceAttr("auth0_connection.github", "options.0.scopes.2296398814", "read_public_key"),
 				),
 			},
+			{
+				// Scopes removed from the configuration must be disabled on
+				// the connection.
+				Config: random.Template(testAccConnectionGitHubConfigUpdate, rand),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_connection.github", "options.0.scopes.#", "2"),
+					resource.TestCheckResourceAttr("auth0_connection.github", "options.0.scopes.881205744", "email"),
+					resource.TestCheckResourceAttr("auth0_connection.github", "options.0.scopes.188173322", "read_org"),
+				),
+			},
 		},
 	})
 }
@@ -967,6 +1228,198 @@ resource "auth0_connection" "github" {
 }
 `
 
+const testAccConnectionGitHubConfigUpdate = `
+
+resource "auth0_connection" "github" {
+	name = "Acceptance-Test-GitHub-{{.random}}"
+	strategy = "github"
+	options {
+		client_id = "client-id"
+		client_secret = "client-secret"
+		scopes = [ "read_org", "email" ]
+	}
+}
+`
+
+func TestAccConnectionRealms(t *testing.T) {
+
+	rand := random.String(6)
+
+	resource.Test(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"auth0": Provider(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      random.Template(testAccConnectionRealmsConfigDuplicate, rand),
+				ExpectError: regexp.MustCompile(`realms must be unique, "example.com" is defined more than once`),
+			},
+			{
+				Config: random.Template(testAccConnectionRealmsConfig, rand),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_connection.my_connection", "realms.#", "2"),
+					resource.TestCheckResourceAttr("auth0_connection.my_connection", "realms.0", "example.com"),
+					resource.TestCheckResourceAttr("auth0_connection.my_connection", "realms.1", "example.org"),
+				),
+			},
+		},
+	})
+}
+
+const testAccConnectionRealmsConfigDuplicate = `
+
+resource "auth0_connection" "my_connection" {
+	name = "Acceptance-Test-Realms-{{.random}}"
+	strategy = "auth0"
+	realms = [ "example.com", "example.org", "example.com" ]
+}
+`
+
+const testAccConnectionRealmsConfig = `
+
+resource "auth0_connection" "my_connection" {
+	name = "Acceptance-Test-Realms-{{.random}}"
+	strategy = "auth0"
+	realms = [ "example.com", "example.org" ]
+}
+`
+
+func TestAccConnectionMetadata(t *testing.T) {
+
+	rand := random.String(6)
+
+	resource.Test(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"auth0": Provider(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: random.Template(testAccConnectionMetadataConfig, rand),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_connection.my_connection", "metadata.%", "2"),
+					resource.TestCheckResourceAttr("auth0_connection.my_connection", "metadata.team", "identity"),
+					resource.TestCheckResourceAttr("auth0_connection.my_connection", "metadata.tier", "gold"),
+				),
+			},
+			{
+				Config: random.Template(testAccConnectionMetadataConfigUpdate, rand),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_connection.my_connection", "metadata.%", "1"),
+					resource.TestCheckResourceAttr("auth0_connection.my_connection", "metadata.team", "platform"),
+				),
+			},
+			{
+				Config: random.Template(testAccConnectionMetadataConfigRemove, rand),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_connection.my_connection", "metadata.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccConnectionMetadataConfig = `
+
+resource "auth0_connection" "my_connection" {
+	name = "Acceptance-Test-Metadata-{{.random}}"
+	strategy = "auth0"
+	metadata = {
+		team = "identity"
+		tier = "gold"
+	}
+}
+`
+
+const testAccConnectionMetadataConfigUpdate = `
+
+resource "auth0_connection" "my_connection" {
+	name = "Acceptance-Test-Metadata-{{.random}}"
+	strategy = "auth0"
+	metadata = {
+		team = "platform"
+	}
+}
+`
+
+const testAccConnectionMetadataConfigRemove = `
+
+resource "auth0_connection" "my_connection" {
+	name = "Acceptance-Test-Metadata-{{.random}}"
+	strategy = "auth0"
+}
+`
+
+func TestAccConnectionOptionsJSON(t *testing.T) {
+
+	rand := random.String(6)
+
+	resource.Test(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"auth0": Provider(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: random.Template(testAccConnectionOptionsJSONConfig, rand),
+				Check: resource.ComposeTestCheckFunc(
+					random.TestCheckResourceAttr("auth0_connection.my_connection", "name", "Acceptance-Test-Options-JSON-{{.random}}", rand),
+					resource.TestCheckResourceAttr("auth0_connection.my_connection", "options.0.brute_force_protection", "true"),
+					resource.TestCheckResourceAttrSet("auth0_connection.my_connection", "options_json"),
+					testAccCheckConnectionRawOption("auth0_connection.my_connection", "non_persistent_attrs", []interface{}{"ethnicity"}),
+					testAccCheckConnectionRawOption("auth0_connection.my_connection", "brute_force_protection", true),
+				),
+			},
+			{
+				// Reformatting the JSON must not produce a diff.
+				Config:             random.Template(strings.Replace(testAccConnectionOptionsJSONConfig, `{"brute_force_protection": false, "non_persistent_attrs": ["ethnicity"]}`, `{ "non_persistent_attrs" : [ "ethnicity" ], "brute_force_protection" : false }`, 1), rand),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+		},
+	})
+}
+
+// testAccCheckConnectionRawOption reads the connection through the Management
+// API and checks the value of one of its raw options, including options the
+// provider doesn't model.
+func testAccCheckConnectionRawOption(name, key string, expected interface{}) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+		api, err := Auth0()
+		if err != nil {
+			return err
+		}
+		c, err := api.Connection.Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		var options map[string]interface{}
+		if err := json.Unmarshal(c.RawOptions, &options); err != nil {
+			return err
+		}
+		if actual := options[key]; !reflect.DeepEqual(actual, expected) {
+			return fmt.Errorf("Expected option %s to be %v, got %v", key, expected, actual)
+		}
+		return nil
+	}
+}
+
+const testAccConnectionOptionsJSONConfig = `
+
+resource "auth0_connection" "my_connection" {
+	name = "Acceptance-Test-Options-JSON-{{.random}}"
+	strategy = "auth0"
+	options {
+		brute_force_protection = true
+	}
+	options_json = <<EOF
+{"brute_force_protection": false, "non_persistent_attrs": ["ethnicity"]}
+EOF
+}
+`
+
 func TestAccConnectionConfiguration(t *testing.T) {
 
 	rand := random.String(6)
@@ -1095,8 +1548,18 @@ func TestAccConnectionSAML(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					random.TestCheckResourceAttr("auth0_connection.my_connection", "name", "Acceptance-Test-SAML-{{.random}}", rand),
 					resource.TestCheckResourceAttr("auth0_connection.my_connection", "strategy", "samlp"),
+					resource.TestCheckResourceAttr("auth0_connection.my_connection", "options.0.signature_algorithm", "rsa-sha256"),
+					resource.TestCheckResourceAttr("auth0_connection.my_connection", "options.0.digest_algorithm", "sha256"),
+					resource.TestCheckResourceAttr("auth0_connection.my_connection", "options.0.idp_initiated.0.client_authorize_query", "type=code&timeout=30"),
+					resource.TestCheckResourceAttrSet("auth0_connection.my_connection", "options.0.request_template"),
 				),
 			},
+			{
+				// Reindenting the request template must not produce a diff.
+				Config:             random.Template(strings.Replace(testConnectionSAMLConfigCreate, `\n    ID=`, `\n        ID=`, 1), rand),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
 			{
 				Config: random.Template(testConnectionSAMLConfigUpdate, rand),
 				Check: resource.ComposeTestCheckFunc(
@@ -1208,3 +1671,93 @@ EOF
 	}
 }
 `
+
+func TestWarnConnectionHasUsers(t *testing.T) {
+
+	for users, expected := range map[string]bool{
+		`[]`:                        false,
+		`[{"user_id":"auth0|123"}]`: true,
+	} {
+		var query string
+		api, done := testAPIStub(t, func(w http.ResponseWriter, r *http.Request) {
+			query = r.URL.Query().Get("q")
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"users":` + users + `,"start":0,"limit":1,"length":0,"total":0}`))
+		})
+
+		var buf bytes.Buffer
+		log.SetOutput(&buf)
+		warnConnectionHasUsers(&providerMeta{Management: api}, "Username-Password-Authentication")
+		log.SetOutput(os.Stderr)
+		done()
+
+		if query != `identities.connection:"Username-Password-Authentication"` {
+			t.Errorf("Unexpected user search query %q", query)
+		}
+		if warned := strings.Contains(buf.String(), "[WARN] Connection Username-Password-Authentication still has users"); warned != expected {
+			t.Errorf("Expected warned to be %t for users %s, got output %q", expected, users, buf.String())
+		}
+	}
+}
+
+func TestConnectionOptionsAuth0StrategyVersion(t *testing.T) {
+
+	d := schema.TestResourceDataRaw(t, newConnection().Schema, map[string]interface{}{
+		"name":     "Username-Password-Authentication",
+		"strategy": "auth0",
+		"options": []interface{}{
+			map[string]interface{}{
+				"strategy_version": 2,
+			},
+		},
+	})
+
+	o, ok := expandConnection(d).Options.(*management.ConnectionOptions)
+	if !ok {
+		t.Fatalf("Unexpected options type %T", expandConnection(d).Options)
+	}
+	if o.GetStrategyVersion() != 2 {
+		t.Errorf("Expected strategy_version 2 to be sent, got %d", o.GetStrategyVersion())
+	}
+
+	m := flattenConnectionOptionsAuth0(d, o).(map[string]interface{})
+	if m["strategy_version"] != 2 {
+		t.Errorf("Expected strategy_version 2 to be read back, got %v", m["strategy_version"])
+	}
+}
+
+func TestConnectionOptionsGoogleOAuth2IconURL(t *testing.T) {
+
+	d := schema.TestResourceDataRaw(t, newConnection().Schema, map[string]interface{}{
+		"name":     "google-oauth2",
+		"strategy": "google-oauth2",
+		"options": []interface{}{
+			map[string]interface{}{
+				"client_id": "abc",
+				"icon_url":  "https://example.com/logo.svg",
+			},
+		},
+	})
+
+	b, err := json.Marshal(expandConnection(d).Options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(b), `"icon_url":"https://example.com/logo.svg"`) {
+		t.Errorf("Expected icon_url to be sent, got options %s", b)
+	}
+
+	// Read the options back the way the SDK decodes a connection.
+	var c management.Connection
+	if err := json.Unmarshal([]byte(`{"strategy":"google-oauth2","options":`+string(b)+`}`), &c); err != nil {
+		t.Fatal(err)
+	}
+
+	m := flattenConnectionOptions(d, &c)[0].(map[string]interface{})
+	if m["icon_url"] != "https://example.com/logo.svg" {
+		t.Errorf("Expected icon_url to be read back, got %v", m["icon_url"])
+	}
+	if m["client_id"] != "abc" {
+		t.Errorf("Expected client_id to be read back, got %v", m["client_id"])
+	}
+}
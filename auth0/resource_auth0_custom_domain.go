@@ -69,7 +69,7 @@ func newCustomDomain() *schema.Resource {
 
 func createCustomDomain(d *schema.ResourceData, m interface{}) error {
 	c := buildCustomDomain(d)
-	api := m.(*management.Management)
+	api := m.(*providerMeta)
 	if err := api.CustomDomain.Create(c); err != nil {
 		return err
 	}
@@ -78,7 +78,7 @@ func createCustomDomain(d *schema.ResourceData, m interface{}) error {
 }
 
 func readCustomDomain(d *schema.ResourceData, m interface{}) error {
-	api := m.(*management.Management)
+	api := m.(*providerMeta)
 	c, err := api.CustomDomain.Read(d.Id())
 	if err != nil {
 		if mErr, ok := err.(management.Error); ok {
@@ -106,7 +106,7 @@ func readCustomDomain(d *schema.ResourceData, m interface{}) error {
 }
 
 func deleteCustomDomain(d *schema.ResourceData, m interface{}) error {
-	api := m.(*management.Management)
+	api := m.(*providerMeta)
 	err := api.CustomDomain.Delete(d.Id())
 	if err != nil {
 		if mErr, ok := err.(management.Error); ok {
@@ -90,13 +90,52 @@ func newEmail() *schema.Resource {
 					},
 				},
 			},
+			"settings": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"headers": {
+							Type:     schema.TypeList,
+							MaxItems: 1,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"x_mc_view_content_link": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"x_ses_configuration_set": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"message": {
+							Type:     schema.TypeList,
+							MaxItems: 1,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"view_content_link": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
 func createEmail(d *schema.ResourceData, m interface{}) error {
 	e := buildEmail(d)
-	api := m.(*management.Management)
+	api := m.(*providerMeta)
 	if err := api.Email.Create(e); err != nil {
 		return err
 	}
@@ -105,7 +144,7 @@ func createEmail(d *schema.ResourceData, m interface{}) error {
 }
 
 func readEmail(d *schema.ResourceData, m interface{}) error {
-	api := m.(*management.Management)
+	api := m.(*providerMeta)
 	e, err := api.Email.Read()
 	if err != nil {
 		if mErr, ok := err.(management.Error); ok {
@@ -114,7 +153,7 @@ func readEmail(d *schema.ResourceData, m interface{}) error {
 				return nil
 			}
 		}
-		return err
+		return withRequiredScope(err, "read:email_provider")
 	}
 
 	d.SetId(auth0.StringValue(e.Name))
@@ -137,12 +176,14 @@ func readEmail(d *schema.ResourceData, m interface{}) error {
 		d.Set("credentials", []map[string]interface{}{credentialsMap})
 	}
 
+	d.Set("settings", flattenEmailSettings(e.Settings))
+
 	return nil
 }
 
 func updateEmail(d *schema.ResourceData, m interface{}) error {
 	e := buildEmail(d)
-	api := m.(*management.Management)
+	api := m.(*providerMeta)
 	err := api.Email.Update(e)
 	if err != nil {
 		return err
@@ -151,7 +192,7 @@ func updateEmail(d *schema.ResourceData, m interface{}) error {
 }
 
 func deleteEmail(d *schema.ResourceData, m interface{}) error {
-	api := m.(*management.Management)
+	api := m.(*providerMeta)
 	err := api.Email.Delete()
 	if err != nil {
 		if mErr, ok := err.(management.Error); ok {
@@ -187,6 +228,25 @@ func buildEmail(d *schema.ResourceData) *management.Email {
 		}
 	})
 
+	List(d, "settings").Elem(func(d ResourceData) {
+		e.Settings = make(map[string]interface{})
+		List(d, "headers").Elem(func(d ResourceData) {
+			headers := make(map[string]interface{})
+			if v, ok := d.GetOk("x_mc_view_content_link"); ok {
+				headers["X-MC-ViewContentLink"] = v
+			}
+			if v, ok := d.GetOk("x_ses_configuration_set"); ok {
+				headers["X-SES-Configuration-Set"] = v
+			}
+			e.Settings["headers"] = headers
+		})
+		List(d, "message").Elem(func(d ResourceData) {
+			e.Settings["message"] = map[string]interface{}{
+				"view_content_link": d.Get("view_content_link").(bool),
+			}
+		})
+	})
+
 	return e
 }
 
@@ -204,3 +264,30 @@ func buildEmailCredentials(m map[string]interface{}) *management.EmailCredential
 		SMTPPass:        String(MapData(m), "smtp_pass"),
 	}
 }
+
+// flattenEmailSettings converts the settings of the email provider, which the
+// API returns as a free form object, into the settings block.
+func flattenEmailSettings(settings map[string]interface{}) []interface{} {
+	m := make(map[string]interface{})
+
+	if headers, ok := settings["headers"].(map[string]interface{}); ok {
+		m["headers"] = []interface{}{
+			map[string]interface{}{
+				"x_mc_view_content_link":  headers["X-MC-ViewContentLink"],
+				"x_ses_configuration_set": headers["X-SES-Configuration-Set"],
+			},
+		}
+	}
+	if message, ok := settings["message"].(map[string]interface{}); ok {
+		m["message"] = []interface{}{
+			map[string]interface{}{
+				"view_content_link": message["view_content_link"],
+			},
+		}
+	}
+
+	if len(m) == 0 {
+		return nil
+	}
+	return []interface{}{m}
+}
@@ -22,57 +22,75 @@ func newEmailTemplate() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
-		Schema: map[string]*schema.Schema{
-			"template": {
-				Type:     schema.TypeString,
-				Required: true,
-				ValidateFunc: validation.StringInSlice([]string{
-					"verify_email",
-					"reset_email",
-					"welcome_email",
-					"blocked_account",
-					"stolen_credentials",
-					"enrollment_email",
-					"change_password",
-					"password_reset",
-					"mfa_oob_code",
-				}, true),
-			},
-			"body": {
-				Type:     schema.TypeString,
-				Required: true,
-			},
-			"from": {
-				Type:     schema.TypeString,
-				Required: true,
-			},
-			"result_url": {
-				Type:     schema.TypeString,
-				Optional: true,
-			},
-			"subject": {
-				Type:     schema.TypeString,
-				Required: true,
-			},
-			"syntax": {
-				Type:     schema.TypeString,
-				Required: true,
-			},
-			"url_lifetime_in_seconds": {
-				Type:     schema.TypeInt,
-				Optional: true,
-			},
-			"enabled": {
-				Type:     schema.TypeBool,
-				Required: true,
-			},
+		Schema: emailTemplateSchema(),
+	}
+}
+
+func emailTemplateSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"template": {
+			Type:     schema.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				"verify_email",
+				"reset_email",
+				"welcome_email",
+				"blocked_account",
+				"stolen_credentials",
+				"enrollment_email",
+				"change_password",
+				"password_reset",
+				"mfa_oob_code",
+			}, true),
+		},
+		"body": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"from": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"result_url": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"subject": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"syntax": {
+			Type:     schema.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				"liquid",
+			}, false),
+		},
+		"url_lifetime_in_seconds": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		"enabled": {
+			Type:     schema.TypeBool,
+			Required: true,
 		},
 	}
 }
 
 func createEmailTemplate(d *schema.ResourceData, m interface{}) error {
 	e := buildEmailTemplate(d)
-	api := m.(*management.Management)
+	api := m.(*providerMeta)
+	if err := upsertEmailTemplate(api, e); err != nil {
+		return err
+	}
+	d.SetId(auth0.StringValue(e.Template))
+	return nil
+}
+
+// upsertEmailTemplate creates the email template, or updates it if it already
+// exists.
+func upsertEmailTemplate(api *providerMeta, e *management.EmailTemplate) error {
 
 	// The email template resource doesn't allow deleting templates, so in order
 	// to avoid conflicts, we first attempt to read the template. If it exists
@@ -81,25 +99,16 @@ func createEmailTemplate(d *schema.ResourceData, m interface{}) error {
 
 		// We succeeded in reading the template, this means it was created
 		// previously.
-		if err := api.EmailTemplate.Update(auth0.StringValue(e.Template), e); err != nil {
-			return err
-		}
-		d.SetId(auth0.StringValue(e.Template))
-		return nil
+		return api.EmailTemplate.Update(auth0.StringValue(e.Template), e)
 	}
 
 	// If we reached this point the template doesn't exist. Therefore it is safe
 	// to create it.
-	if err := api.EmailTemplate.Create(e); err != nil {
-		return err
-	}
-	d.SetId(auth0.StringValue(e.Template))
-
-	return nil
+	return api.EmailTemplate.Create(e)
 }
 
 func readEmailTemplate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*management.Management)
+	api := m.(*providerMeta)
 	e, err := api.EmailTemplate.Read(d.Id())
 	if err != nil {
 		if mErr, ok := err.(management.Error); ok {
@@ -124,7 +133,7 @@ func readEmailTemplate(d *schema.ResourceData, m interface{}) error {
 
 func updateEmailTemplate(d *schema.ResourceData, m interface{}) error {
 	e := buildEmailTemplate(d)
-	api := m.(*management.Management)
+	api := m.(*providerMeta)
 	err := api.EmailTemplate.Update(d.Id(), e)
 	if err != nil {
 		return err
@@ -133,7 +142,7 @@ func updateEmailTemplate(d *schema.ResourceData, m interface{}) error {
 }
 
 func deleteEmailTemplate(d *schema.ResourceData, m interface{}) error {
-	api := m.(*management.Management)
+	api := m.(*providerMeta)
 	t := &management.EmailTemplate{
 		Template: auth0.String(d.Id()),
 		Enabled:  auth0.Bool(false),
@@ -150,7 +159,7 @@ func deleteEmailTemplate(d *schema.ResourceData, m interface{}) error {
 	return err
 }
 
-func buildEmailTemplate(d *schema.ResourceData) *management.EmailTemplate {
+func buildEmailTemplate(d ResourceData) *management.EmailTemplate {
 	t := &management.EmailTemplate{
 		Template:              String(d, "template"),
 		Body:                  String(d, "body"),
@@ -0,0 +1,149 @@
+package auth0
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"gopkg.in/auth0.v4"
+	"gopkg.in/auth0.v4/management"
+)
+
+func newEmailTemplates() *schema.Resource {
+	return &schema.Resource{
+
+		Create: createEmailTemplates,
+		Read:   readEmailTemplates,
+		Update: updateEmailTemplates,
+		Delete: deleteEmailTemplates,
+
+		Schema: map[string]*schema.Schema{
+			"templates": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: emailTemplateSchema(),
+				},
+			},
+		},
+	}
+}
+
+func createEmailTemplates(d *schema.ResourceData, m interface{}) error {
+	templates, err := expandEmailTemplates(d)
+	if err != nil {
+		return err
+	}
+	api := m.(*providerMeta)
+	for _, e := range templates {
+		if err := upsertEmailTemplate(api, e); err != nil {
+			return err
+		}
+	}
+	d.SetId(resource.UniqueId())
+	return readEmailTemplates(d, m)
+}
+
+func readEmailTemplates(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerMeta)
+
+	var templates []interface{}
+	for _, v := range Set(d, "templates").List() {
+		name := v.(map[string]interface{})["template"].(string)
+		e, err := api.EmailTemplate.Read(name)
+		if err != nil {
+			if mErr, ok := err.(management.Error); ok {
+				if mErr.Status() == http.StatusNotFound {
+					continue
+				}
+			}
+			return err
+		}
+		templates = append(templates, map[string]interface{}{
+			"template":                e.Template,
+			"body":                    e.Body,
+			"from":                    e.From,
+			"result_url":              e.ResultURL,
+			"subject":                 e.Subject,
+			"syntax":                  e.Syntax,
+			"url_lifetime_in_seconds": e.URLLifetimeInSecoonds,
+			"enabled":                 e.Enabled,
+		})
+	}
+	d.Set("templates", templates)
+	return nil
+}
+
+func updateEmailTemplates(d *schema.ResourceData, m interface{}) error {
+	templates, err := expandEmailTemplates(d)
+	if err != nil {
+		return err
+	}
+
+	api := m.(*providerMeta)
+
+	// Templates which are no longer configured are disabled, as templates
+	// can't be deleted.
+	add, rm := Diff(d, "templates")
+	for _, v := range rm {
+		name := v.(map[string]interface{})["template"].(string)
+		if _, ok := templates[name]; !ok {
+			if err := disableEmailTemplate(api, name); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, v := range add {
+		name := v.(map[string]interface{})["template"].(string)
+		if err := upsertEmailTemplate(api, templates[name]); err != nil {
+			return err
+		}
+	}
+
+	return readEmailTemplates(d, m)
+}
+
+func deleteEmailTemplates(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerMeta)
+	for _, v := range Set(d, "templates").List() {
+		name := v.(map[string]interface{})["template"].(string)
+		if err := disableEmailTemplate(api, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func disableEmailTemplate(api *providerMeta, name string) error {
+	err := api.EmailTemplate.Update(name, &management.EmailTemplate{
+		Template: auth0.String(name),
+		Enabled:  auth0.Bool(false),
+	})
+	if err != nil {
+		if mErr, ok := err.(management.Error); ok {
+			if mErr.Status() == http.StatusNotFound {
+				return nil
+			}
+		}
+	}
+	return err
+}
+
+// expandEmailTemplates returns the configured templates keyed by their name,
+// returning an error if the same template is configured more than once.
+func expandEmailTemplates(d *schema.ResourceData) (map[string]*management.EmailTemplate, error) {
+	templates := make(map[string]*management.EmailTemplate)
+	var err error
+	Set(d, "templates").Elem(func(d ResourceData) {
+		e := buildEmailTemplate(d)
+		name := auth0.StringValue(e.Template)
+		if _, ok := templates[name]; ok {
+			err = fmt.Errorf("email template %q is configured more than once", name)
+		}
+		templates[name] = e
+	})
+	return templates, err
+}
@@ -92,6 +92,55 @@ func TestAccEmail(t *testing.T) {
 					resource.TestCheckResourceAttr("auth0_email.my_email_provider", "credentials.0.region", "eu"),
 				),
 			},
+			{
+				Config: `
+				resource "auth0_email" "my_email_provider" {
+					name = "smtp"
+					enabled = true
+					default_from_address = "accounts@example.com"
+					credentials {
+						smtp_host = "smtp.example.com"
+						smtp_port = 587
+						smtp_user = "accounts"
+						smtp_pass = "password"
+					}
+					settings {
+						headers {
+							x_mc_view_content_link = "false"
+							x_ses_configuration_set = "my-configuration-set"
+						}
+					}
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_email.my_email_provider", "name", "smtp"),
+					resource.TestCheckResourceAttr("auth0_email.my_email_provider", "settings.#", "1"),
+					resource.TestCheckResourceAttr("auth0_email.my_email_provider", "settings.0.headers.0.x_mc_view_content_link", "false"),
+					resource.TestCheckResourceAttr("auth0_email.my_email_provider", "settings.0.headers.0.x_ses_configuration_set", "my-configuration-set"),
+				),
+			},
+			{
+				Config: `
+				resource "auth0_email" "my_email_provider" {
+					name = "mandrill"
+					enabled = true
+					default_from_address = "accounts@example.com"
+					credentials {
+						api_key = "MANDRILLXXXXXXXXXXXXXX"
+					}
+					settings {
+						message {
+							view_content_link = true
+						}
+					}
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_email.my_email_provider", "name", "mandrill"),
+					resource.TestCheckResourceAttr("auth0_email.my_email_provider", "settings.#", "1"),
+					resource.TestCheckResourceAttr("auth0_email.my_email_provider", "settings.0.message.0.view_content_link", "true"),
+				),
+			},
 		},
 	})
 }
@@ -29,6 +29,12 @@ func newGlobalClient() *schema.Resource {
 		client.Schema[key].Computed = true
 	}
 
+	// Origins are only validated by auth0_client. The global client's origins
+	// were set before they were validated, and may not all pass.
+	for _, key := range []string{"allowed_origins", "web_origins"} {
+		client.Schema[key].Elem.(*schema.Schema).ValidateFunc = nil
+	}
+
 	return client
 }
 
@@ -49,8 +55,11 @@ func createGlobalClient(d *schema.ResourceData, m interface{}) error {
 }
 
 func readGlobalClientId(d *schema.ResourceData, m interface{}) error {
-	api := m.(*management.Management)
-	clients, err := api.Client.List(management.Parameter("is_global", "true"), management.WithFields("client_id"))
+	api := m.(*providerMeta)
+	clients, err := api.Client.List(
+		management.Parameter("is_global", "true"),
+		management.WithFields("client_id"),
+		api.perPage())
 	if err != nil {
 		return err
 	}
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 )
 
@@ -76,3 +77,17 @@ resource "auth0_global_client" "global" {
     custom_login_page_on = false
 }
 `
+
+func TestGlobalClientOriginsNotValidated(t *testing.T) {
+
+	client, global := newClient(), newGlobalClient()
+
+	for _, key := range []string{"allowed_origins", "web_origins"} {
+		if client.Schema[key].Elem.(*schema.Schema).ValidateFunc == nil {
+			t.Errorf("Expected auth0_client %s to be validated", key)
+		}
+		if global.Schema[key].Elem.(*schema.Schema).ValidateFunc != nil {
+			t.Errorf("Expected auth0_global_client %s not to be validated", key)
+		}
+	}
+}
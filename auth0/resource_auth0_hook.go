@@ -61,7 +61,7 @@ func newHook() *schema.Resource {
 
 func createHook(d *schema.ResourceData, m interface{}) error {
 	c := buildHook(d)
-	api := m.(*management.Management)
+	api := m.(*providerMeta)
 	if err := api.Hook.Create(c); err != nil {
 		return err
 	}
@@ -70,7 +70,7 @@ func createHook(d *schema.ResourceData, m interface{}) error {
 }
 
 func readHook(d *schema.ResourceData, m interface{}) error {
-	api := m.(*management.Management)
+	api := m.(*providerMeta)
 	c, err := api.Hook.Read(d.Id())
 	if err != nil {
 		if mErr, ok := err.(management.Error); ok {
@@ -91,7 +91,7 @@ func readHook(d *schema.ResourceData, m interface{}) error {
 
 func updateHook(d *schema.ResourceData, m interface{}) error {
 	c := buildHook(d)
-	api := m.(*management.Management)
+	api := m.(*providerMeta)
 	err := api.Hook.Update(d.Id(), c)
 	if err != nil {
 		return err
@@ -100,7 +100,7 @@ func updateHook(d *schema.ResourceData, m interface{}) error {
 }
 
 func deleteHook(d *schema.ResourceData, m interface{}) error {
-	api := m.(*management.Management)
+	api := m.(*providerMeta)
 	err := api.Hook.Delete(d.Id())
 	if err != nil {
 		if mErr, ok := err.(management.Error); ok {
@@ -0,0 +1,158 @@
+package auth0
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"gopkg.in/auth0.v4/management"
+)
+
+func newHookSecrets() *schema.Resource {
+	return &schema.Resource{
+
+		Create: createHookSecrets,
+		Read:   readHookSecrets,
+		Update: updateHookSecrets,
+		Delete: deleteHookSecrets,
+
+		Schema: map[string]*schema.Schema{
+			"hook_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the hook the secrets belong to",
+			},
+			"secrets": {
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Required:    true,
+				Sensitive:   true,
+				Description: "Secrets of the hook, keyed by name",
+			},
+		},
+	}
+}
+
+func createHookSecrets(d *schema.ResourceData, m interface{}) error {
+	hookID := d.Get("hook_id").(string)
+
+	globalMutexKV.Lock(hookID)
+	defer globalMutexKV.Unlock(hookID)
+
+	api := m.(*providerMeta)
+	secrets := expandHookSecrets(d.Get("secrets").(map[string]interface{}))
+	if err := api.Hook.CreateSecrets(hookID, &secrets); err != nil {
+		return err
+	}
+
+	d.SetId(hookID)
+	return readHookSecrets(d, m)
+}
+
+func readHookSecrets(d *schema.ResourceData, m interface{}) error {
+	api := m.(*providerMeta)
+	secrets, err := api.Hook.Secrets(d.Id())
+	if err != nil {
+		if mErr, ok := err.(management.Error); ok {
+			if mErr.Status() == http.StatusNotFound {
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	d.Set("hook_id", d.Id())
+	d.Set("secrets", flattenHookSecrets(d.Get("secrets").(map[string]interface{}), *secrets))
+	return nil
+}
+
+func updateHookSecrets(d *schema.ResourceData, m interface{}) error {
+	hookID := d.Id()
+
+	globalMutexKV.Lock(hookID)
+	defer globalMutexKV.Unlock(hookID)
+
+	api := m.(*providerMeta)
+	o, n := d.GetChange("secrets")
+	oldSecrets, newSecrets := o.(map[string]interface{}), n.(map[string]interface{})
+
+	var rm []string
+	add, update := make(management.HookSecrets), make(management.HookSecrets)
+	for k := range oldSecrets {
+		if _, ok := newSecrets[k]; !ok {
+			rm = append(rm, k)
+		}
+	}
+	for k, v := range newSecrets {
+		if ov, ok := oldSecrets[k]; !ok {
+			add[k] = v.(string)
+		} else if ov != v {
+			update[k] = v.(string)
+		}
+	}
+
+	if len(rm) > 0 {
+		if err := api.Hook.RemoveSecrets(hookID, rm...); err != nil {
+			return err
+		}
+	}
+	if len(update) > 0 {
+		if err := api.Hook.UpdateSecrets(hookID, &update); err != nil {
+			return err
+		}
+	}
+	if len(add) > 0 {
+		if err := api.Hook.CreateSecrets(hookID, &add); err != nil {
+			return err
+		}
+	}
+
+	return readHookSecrets(d, m)
+}
+
+func deleteHookSecrets(d *schema.ResourceData, m interface{}) error {
+	hookID := d.Id()
+
+	globalMutexKV.Lock(hookID)
+	defer globalMutexKV.Unlock(hookID)
+
+	secrets := expandHookSecrets(d.Get("secrets").(map[string]interface{}))
+	if len(secrets) == 0 {
+		return nil
+	}
+
+	api := m.(*providerMeta)
+	err := api.Hook.RemoveSecrets(hookID, secrets.Keys()...)
+	if err != nil {
+		if mErr, ok := err.(management.Error); ok {
+			if mErr.Status() == http.StatusNotFound {
+				d.SetId("")
+				return nil
+			}
+		}
+	}
+	return err
+}
+
+func expandHookSecrets(m map[string]interface{}) management.HookSecrets {
+	secrets := make(management.HookSecrets, len(m))
+	for k, v := range m {
+		secrets[k] = v.(string)
+	}
+	return secrets
+}
+
+// flattenHookSecrets keeps the configured value of every secret that still
+// exists on the hook, as Auth0 never returns secret values. Secrets removed
+// outside of Terraform are dropped, so that they are created again.
+func flattenHookSecrets(configured map[string]interface{}, secrets management.HookSecrets) map[string]interface{} {
+	m := make(map[string]interface{}, len(configured))
+	for k, v := range configured {
+		if _, ok := secrets[k]; ok {
+			m[k] = v
+		}
+	}
+	return m
+}
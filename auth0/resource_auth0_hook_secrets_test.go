@@ -0,0 +1,145 @@
+package auth0
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/alexkappa/terraform-provider-auth0/auth0/internal/random"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"gopkg.in/auth0.v4/management"
+)
+
+func TestAccHookSecrets(t *testing.T) {
+
+	rand := random.String(6)
+
+	resource.Test(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"auth0": Provider(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: random.Template(testAccHookSecretsCreate, rand),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("auth0_hook_secrets.my_secrets", "hook_id", "auth0_hook.my_hook", "id"),
+					resource.TestCheckResourceAttr("auth0_hook_secrets.my_secrets", "secrets.%", "2"),
+					resource.TestCheckResourceAttr("auth0_hook_secrets.my_secrets", "secrets.api_key", "foo"),
+					resource.TestCheckResourceAttr("auth0_hook_secrets.my_secrets", "secrets.api_secret", "bar"),
+					testAccCheckHookSecretKeys("auth0_hook_secrets.my_secrets", "api_key", "api_secret"),
+				),
+			},
+			{
+				// Rotating a secret must only update the secret.
+				Config: random.Template(testAccHookSecretsRotate, rand),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_hook_secrets.my_secrets", "secrets.%", "2"),
+					resource.TestCheckResourceAttr("auth0_hook_secrets.my_secrets", "secrets.api_key", "baz"),
+					resource.TestCheckResourceAttr("auth0_hook_secrets.my_secrets", "secrets.api_secret", "bar"),
+					resource.TestCheckResourceAttr("auth0_hook.my_hook", "script", "function (user, context, callback) { callback(null, { user }); }"),
+					testAccCheckHookSecretKeys("auth0_hook_secrets.my_secrets", "api_key", "api_secret"),
+				),
+			},
+			{
+				Config: random.Template(testAccHookSecretsReplace, rand),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_hook_secrets.my_secrets", "secrets.%", "2"),
+					resource.TestCheckResourceAttr("auth0_hook_secrets.my_secrets", "secrets.api_key", "baz"),
+					resource.TestCheckResourceAttr("auth0_hook_secrets.my_secrets", "secrets.webhook_token", "qux"),
+					testAccCheckHookSecretKeys("auth0_hook_secrets.my_secrets", "api_key", "webhook_token"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckHookSecretKeys checks the hook holds exactly the given secrets.
+// Their values can't be checked, as Auth0 never returns them.
+func testAccCheckHookSecretKeys(name string, keys ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+		api, err := Auth0()
+		if err != nil {
+			return err
+		}
+		secrets, err := api.Hook.Secrets(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		actual := secrets.Keys()
+		sort.Strings(actual)
+		sort.Strings(keys)
+		if !reflect.DeepEqual(actual, keys) {
+			return fmt.Errorf("Expected hook secrets %v, got %v", keys, actual)
+		}
+		return nil
+	}
+}
+
+const testAccHookSecretsAux = `
+
+resource "auth0_hook" "my_hook" {
+  name = "hook-secrets-{{.random}}"
+  trigger_id = "pre-user-registration"
+  script = "function (user, context, callback) { callback(null, { user }); }"
+  enabled = true
+}
+`
+
+const testAccHookSecretsCreate = testAccHookSecretsAux + `
+
+resource "auth0_hook_secrets" "my_secrets" {
+  hook_id = "${auth0_hook.my_hook.id}"
+  secrets = {
+    api_key = "foo"
+    api_secret = "bar"
+  }
+}
+`
+
+const testAccHookSecretsRotate = testAccHookSecretsAux + `
+
+resource "auth0_hook_secrets" "my_secrets" {
+  hook_id = "${auth0_hook.my_hook.id}"
+  secrets = {
+    api_key = "baz"
+    api_secret = "bar"
+  }
+}
+`
+
+const testAccHookSecretsReplace = testAccHookSecretsAux + `
+
+resource "auth0_hook_secrets" "my_secrets" {
+  hook_id = "${auth0_hook.my_hook.id}"
+  secrets = {
+    api_key = "baz"
+    webhook_token = "qux"
+  }
+}
+`
+
+func TestFlattenHookSecrets(t *testing.T) {
+
+	configured := map[string]interface{}{
+		"api_key":    "foo",
+		"api_secret": "bar",
+	}
+
+	// Auth0 redacts the values, and api_secret was removed outside of
+	// Terraform.
+	secrets := management.HookSecrets{
+		"api_key":   "_VALUE_NOT_SHOWN_",
+		"unmanaged": "_VALUE_NOT_SHOWN_",
+	}
+
+	expected := map[string]interface{}{"api_key": "foo"}
+	if actual := flattenHookSecrets(configured, secrets); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Expected %v, got %v", expected, actual)
+	}
+}
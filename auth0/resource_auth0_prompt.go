@@ -41,7 +41,7 @@ func createPrompt(d *schema.ResourceData, m interface{}) error {
 }
 
 func readPrompt(d *schema.ResourceData, m interface{}) error {
-	api := m.(*management.Management)
+	api := m.(*providerMeta)
 	p, err := api.Prompt.Read()
 	if err != nil {
 		if mErr, ok := err.(management.Error); ok {
@@ -50,7 +50,7 @@ func readPrompt(d *schema.ResourceData, m interface{}) error {
 				return nil
 			}
 		}
-		return err
+		return withRequiredScope(err, "read:prompts")
 	}
 	d.Set("universal_login_experience", p.UniversalLoginExperience)
 	return nil
@@ -58,7 +58,7 @@ func readPrompt(d *schema.ResourceData, m interface{}) error {
 
 func updatePrompt(d *schema.ResourceData, m interface{}) error {
 	p := buildPrompt(d)
-	api := m.(*management.Management)
+	api := m.(*providerMeta)
 	err := api.Prompt.Update(p)
 	if err != nil {
 		return err
@@ -3,12 +3,16 @@ package auth0
 import (
 	"fmt"
 	"net/http"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 
 	"gopkg.in/auth0.v4"
 	"gopkg.in/auth0.v4/management"
+
+	"github.com/alexkappa/terraform-provider-auth0/auth0/internal/diff"
 )
 
 func newResourceServer() *schema.Resource {
@@ -43,11 +47,13 @@ func newResourceServer() *schema.Resource {
 							Required: true,
 						},
 						"description": {
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:             schema.TypeString,
+							Optional:         true,
+							DiffSuppressFunc: diff.IgnoreSurroundingWhitespace,
 						},
 					},
 				},
+				Set: hashResourceServerScope,
 			},
 			"signing_alg": {
 				Type:     schema.TypeString,
@@ -116,7 +122,7 @@ func newResourceServer() *schema.Resource {
 
 func createResourceServer(d *schema.ResourceData, m interface{}) error {
 	s := expandResourceServer(d)
-	api := m.(*management.Management)
+	api := m.(*providerMeta)
 	if err := api.ResourceServer.Create(s); err != nil {
 		return err
 	}
@@ -125,7 +131,7 @@ func createResourceServer(d *schema.ResourceData, m interface{}) error {
 }
 
 func readResourceServer(d *schema.ResourceData, m interface{}) error {
-	api := m.(*management.Management)
+	api := m.(*providerMeta)
 	s, err := api.ResourceServer.Read(d.Id())
 	if err != nil {
 		if mErr, ok := err.(management.Error); ok {
@@ -165,7 +171,7 @@ func readResourceServer(d *schema.ResourceData, m interface{}) error {
 func updateResourceServer(d *schema.ResourceData, m interface{}) error {
 	s := expandResourceServer(d)
 	s.Identifier = nil
-	api := m.(*management.Management)
+	api := m.(*providerMeta)
 	err := api.ResourceServer.Update(d.Id(), s)
 	if err != nil {
 		return err
@@ -174,7 +180,7 @@ func updateResourceServer(d *schema.ResourceData, m interface{}) error {
 }
 
 func deleteResourceServer(d *schema.ResourceData, m interface{}) error {
-	api := m.(*management.Management)
+	api := m.(*providerMeta)
 	err := api.ResourceServer.Delete(d.Id())
 	if err != nil {
 		if mErr, ok := err.(management.Error); ok {
@@ -214,3 +220,13 @@ func expandResourceServer(d *schema.ResourceData) *management.ResourceServer {
 
 	return s
 }
+
+// hashResourceServerScope hashes a scope the same way schema.HashResource
+// would, except that whitespace surrounding the description is ignored, as
+// Auth0 trims it before storing the scope.
+func hashResourceServerScope(v interface{}) int {
+	m := v.(map[string]interface{})
+	value, _ := m["value"].(string)
+	description, _ := m["description"].(string)
+	return hashcode.String(fmt.Sprintf("description:%s;value:%s;", strings.TrimSpace(description), value))
+}
@@ -0,0 +1,193 @@
+package auth0
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"gopkg.in/auth0.v4"
+	"gopkg.in/auth0.v4/management"
+)
+
+func newResourceServerScope() *schema.Resource {
+	return &schema.Resource{
+
+		Create: createResourceServerScope,
+		Read:   readResourceServerScope,
+		Update: updateResourceServerScope,
+		Delete: deleteResourceServerScope,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource_server_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the resource server the scope belongs to",
+			},
+			"resource_server_identifier": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Identifier of the resource server the scope belongs to",
+			},
+			"scope": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the scope (permission)",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the scope (permission)",
+			},
+		},
+	}
+}
+
+func createResourceServerScope(d *schema.ResourceData, m interface{}) error {
+	id := d.Get("resource_server_id").(string)
+	scope := d.Get("scope").(string)
+
+	globalMutexKV.Lock(id)
+	defer globalMutexKV.Unlock(id)
+
+	api := m.(*providerMeta)
+	s, err := api.ResourceServer.Read(id)
+	if err != nil {
+		return err
+	}
+
+	scopes := s.Scopes
+	if i := indexOfResourceServerScope(scopes, scope); i >= 0 {
+		scopes[i].Description = String(d, "description")
+	} else {
+		scopes = append(scopes, &management.ResourceServerScope{
+			Value:       auth0.String(scope),
+			Description: String(d, "description"),
+		})
+	}
+
+	err = api.ResourceServer.Update(id, &management.ResourceServer{Scopes: scopes})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(resourceServerScopeID(id, scope))
+	return readResourceServerScope(d, m)
+}
+
+func readResourceServerScope(d *schema.ResourceData, m interface{}) error {
+	id, scope, err := parseResourceServerScopeID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	api := m.(*providerMeta)
+	s, err := api.ResourceServer.Read(id)
+	if err != nil {
+		if mErr, ok := err.(management.Error); ok {
+			if mErr.Status() == http.StatusNotFound {
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	i := indexOfResourceServerScope(s.Scopes, scope)
+	if i < 0 {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("resource_server_id", id)
+	d.Set("resource_server_identifier", s.Identifier)
+	d.Set("scope", scope)
+	d.Set("description", s.Scopes[i].Description)
+	return nil
+}
+
+func updateResourceServerScope(d *schema.ResourceData, m interface{}) error {
+	id := d.Get("resource_server_id").(string)
+	scope := d.Get("scope").(string)
+
+	globalMutexKV.Lock(id)
+	defer globalMutexKV.Unlock(id)
+
+	api := m.(*providerMeta)
+	s, err := api.ResourceServer.Read(id)
+	if err != nil {
+		return err
+	}
+
+	i := indexOfResourceServerScope(s.Scopes, scope)
+	if i < 0 {
+		return fmt.Errorf("scope %q no longer exists on resource server %q", scope, id)
+	}
+	s.Scopes[i].Description = String(d, "description")
+
+	err = api.ResourceServer.Update(id, &management.ResourceServer{Scopes: s.Scopes})
+	if err != nil {
+		return err
+	}
+	return readResourceServerScope(d, m)
+}
+
+func deleteResourceServerScope(d *schema.ResourceData, m interface{}) error {
+	id := d.Get("resource_server_id").(string)
+	scope := d.Get("scope").(string)
+
+	globalMutexKV.Lock(id)
+	defer globalMutexKV.Unlock(id)
+
+	api := m.(*providerMeta)
+	s, err := api.ResourceServer.Read(id)
+	if err != nil {
+		if mErr, ok := err.(management.Error); ok {
+			if mErr.Status() == http.StatusNotFound {
+				return nil
+			}
+		}
+		return err
+	}
+
+	i := indexOfResourceServerScope(s.Scopes, scope)
+	if i < 0 {
+		return nil
+	}
+
+	scopes := make([]*management.ResourceServerScope, 0, len(s.Scopes)-1)
+	scopes = append(scopes, s.Scopes[:i]...)
+	scopes = append(scopes, s.Scopes[i+1:]...)
+
+	return api.ResourceServer.Update(id, &management.ResourceServer{Scopes: scopes})
+}
+
+func indexOfResourceServerScope(scopes []*management.ResourceServerScope, value string) int {
+	for i, scope := range scopes {
+		if scope.GetValue() == value {
+			return i
+		}
+	}
+	return -1
+}
+
+// Scopes commonly contain a colon (e.g. read:users), so the resource server ID
+// and the scope are separated by a double colon.
+func resourceServerScopeID(id, scope string) string {
+	return id + "::" + scope
+}
+
+func parseResourceServerScopeID(id string) (resourceServerID, scope string, err error) {
+	i := strings.Index(id, "::")
+	if i <= 0 || i+2 == len(id) {
+		return "", "", fmt.Errorf("unexpected format of ID (%q), expected <resource_server_id>::<scope>", id)
+	}
+	return id[:i], id[i+2:], nil
+}
@@ -0,0 +1,152 @@
+package auth0
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/alexkappa/terraform-provider-auth0/auth0/internal/random"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"gopkg.in/auth0.v4"
+	"gopkg.in/auth0.v4/management"
+)
+
+func TestAccResourceServerScope(t *testing.T) {
+
+	rand := random.String(6)
+
+	resource.Test(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"auth0": Provider(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: random.Template(testAccResourceServerScopeConfigCreate, rand),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("auth0_resource_server_scope.read", "resource_server_id", "auth0_resource_server.my_resource_server", "id"),
+					random.TestCheckResourceAttr("auth0_resource_server_scope.read", "resource_server_identifier", "https://uat.api.alexkappa.com/scope/{{.random}}", rand),
+					resource.TestCheckResourceAttr("auth0_resource_server_scope.read", "scope", "read:things"),
+					resource.TestCheckResourceAttr("auth0_resource_server_scope.read", "description", "Read things"),
+					resource.TestCheckResourceAttr("auth0_resource_server_scope.write", "scope", "write:things"),
+					resource.TestCheckResourceAttr("auth0_resource_server_scope.write", "description", "Write things"),
+				),
+			},
+			{
+				Config: random.Template(testAccResourceServerScopeConfigUpdate, rand),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_resource_server_scope.read", "description", "Read all things"),
+					resource.TestCheckResourceAttr("auth0_resource_server_scope.write", "description", "Write things"),
+				),
+			},
+			{
+				ResourceName:      "auth0_resource_server_scope.read",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccResourceServerScopeConfig = `
+
+resource "auth0_resource_server" "my_resource_server" {
+	name = "Acceptance Test - Scope - {{.random}}"
+	identifier = "https://uat.api.alexkappa.com/scope/{{.random}}"
+
+	lifecycle {
+		ignore_changes = [ scopes ]
+	}
+}
+
+resource "auth0_resource_server_scope" "write" {
+	resource_server_id = "${auth0_resource_server.my_resource_server.id}"
+	scope = "write:things"
+	description = "Write things"
+}
+`
+
+const testAccResourceServerScopeConfigCreate = testAccResourceServerScopeConfig + `
+
+resource "auth0_resource_server_scope" "read" {
+	resource_server_id = "${auth0_resource_server.my_resource_server.id}"
+	scope = "read:things"
+	description = "Read things"
+}
+`
+
+const testAccResourceServerScopeConfigUpdate = testAccResourceServerScopeConfig + `
+
+resource "auth0_resource_server_scope" "read" {
+	resource_server_id = "${auth0_resource_server.my_resource_server.id}"
+	scope = "read:things"
+	description = "Read all things"
+}
+`
+
+func TestParseResourceServerScopeID(t *testing.T) {
+	id, scope, err := parseResourceServerScopeID(resourceServerScopeID("5f0c1e7a9c0a4e0034a1b2c3", "read:users"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != "5f0c1e7a9c0a4e0034a1b2c3" || scope != "read:users" {
+		t.Errorf("Unexpected result %q, %q", id, scope)
+	}
+
+	for _, id := range []string{"", "5f0c1e7a9c0a4e0034a1b2c3", "::read:users", "5f0c1e7a9c0a4e0034a1b2c3::"} {
+		if _, _, err := parseResourceServerScopeID(id); err == nil {
+			t.Errorf("Expected an error parsing %q", id)
+		}
+	}
+}
+
+func TestResourceServerScopeRequestPaths(t *testing.T) {
+
+	rs := &management.ResourceServer{
+		ID:         auth0.String("rs_123"),
+		Identifier: auth0.String("https://uat.api.example.com/scope/abc"),
+	}
+
+	var paths []string
+	api, done := testAPIStub(t, func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.Method+" "+r.URL.EscapedPath())
+		if r.URL.Path != "/api/v2/resource-servers/rs_123" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"statusCode":404,"message":"Not Found"}`))
+			return
+		}
+		if r.Method == http.MethodPatch {
+			var update management.ResourceServer
+			json.NewDecoder(r.Body).Decode(&update)
+			rs.Scopes = update.Scopes
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(rs)
+	})
+	defer done()
+
+	d := schema.TestResourceDataRaw(t, newResourceServerScope().Schema, map[string]interface{}{
+		"resource_server_id": "rs_123",
+		"scope":              "read:foo",
+	})
+	if err := createResourceServerScope(d, &providerMeta{Management: api}); err != nil {
+		t.Fatal(err)
+	}
+
+	if d.Id() != "rs_123::read:foo" {
+		t.Errorf("Expected the scope to be kept in state, got ID %q", d.Id())
+	}
+	if v := d.Get("resource_server_identifier"); v != "https://uat.api.example.com/scope/abc" {
+		t.Errorf("Unexpected resource_server_identifier %q", v)
+	}
+	expected := []string{
+		"GET /api/v2/resource-servers/rs_123",
+		"PATCH /api/v2/resource-servers/rs_123",
+		"GET /api/v2/resource-servers/rs_123",
+	}
+	if !reflect.DeepEqual(paths, expected) {
+		t.Errorf("Expected requests %v, got %v", expected, paths)
+	}
+}
@@ -1,6 +1,7 @@
 package auth0
 
 import (
+	"fmt"
 	"strings"
 	"testing"
 
@@ -72,6 +73,13 @@ func TestAccResourceServer(t *testing.T) {
 					resource.TestCheckResourceAttr("auth0_resource_server.my_resource_server", "scopes.3536702635.description", "Create bars"),
 				),
 			},
+			{
+				// Auth0 trims scope descriptions, so surrounding whitespace in
+				// the configuration must not produce a diff.
+				Config:             random.Template(strings.Replace(testAccResourceServerConfigCreate, `"Create foos"`, `"  Create foos "`, 1), rand),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
 			{
 				Config: random.Template(testAccResourceServerConfigUpdate, rand),
 				Check: resource.ComposeTestCheckFunc(
@@ -128,3 +136,114 @@ resource "auth0_resource_server" "my_resource_server" {
 	enforce_policies = true
 }
 `
+
+func TestAccResourceServerDependentsReconcile(t *testing.T) {
+
+	rand := random.String(6)
+
+	// The resource server is deleted by ID, as the SDK doesn't escape
+	// identifiers in request paths.
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"auth0": Provider(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: random.Template(testAccResourceServerDependentsConfig, rand),
+				Check: resource.ComposeTestCheckFunc(
+					func(s *terraform.State) error {
+						rs, ok := s.RootModule().Resources["auth0_resource_server.my_resource_server"]
+						if !ok {
+							return fmt.Errorf("Not found: auth0_resource_server.my_resource_server")
+						}
+						id = rs.Primary.ID
+						return nil
+					},
+					resource.TestCheckResourceAttr("auth0_client_grant.my_client_grant", "scope.#", "1"),
+					resource.TestCheckResourceAttr("auth0_role.my_role", "permissions.#", "1"),
+				),
+			},
+			{
+				// Delete the resource server outside of Terraform, which
+				// deletes its client grants and role permissions with it.
+				// Refreshing must not fail, and applying must create the
+				// resource server and client grant again and reassign the
+				// role's permission.
+				PreConfig: func() {
+					api, err := Auth0()
+					if err != nil {
+						t.Fatal(err)
+					}
+					err = api.ResourceServer.Delete(id)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: random.Template(testAccResourceServerDependentsConfig, rand),
+				Check: resource.ComposeTestCheckFunc(
+					random.TestCheckResourceAttr("auth0_resource_server.my_resource_server", "identifier", "https://uat.api.alexkappa.com/dependents/{{.random}}", rand),
+					resource.TestCheckResourceAttr("auth0_client_grant.my_client_grant", "scope.#", "1"),
+					resource.TestCheckResourceAttrPair("auth0_client_grant.my_client_grant", "audience", "auth0_resource_server.my_resource_server", "identifier"),
+					resource.TestCheckResourceAttr("auth0_role.my_role", "permissions.#", "1"),
+					testAccCheckRolePermissionCount("auth0_role.my_role", 1),
+				),
+			},
+		},
+	})
+}
+
+const testAccResourceServerDependentsConfig = `
+
+resource "auth0_resource_server" "my_resource_server" {
+	name = "Acceptance Test - Dependents - {{.random}}"
+	identifier = "https://uat.api.alexkappa.com/dependents/{{.random}}"
+	scopes {
+		value = "create:foo"
+		description = "Create foos"
+	}
+}
+
+resource "auth0_client" "my_client" {
+	name = "Acceptance Test - Dependents - {{.random}}"
+	app_type = "non_interactive"
+}
+
+resource "auth0_client_grant" "my_client_grant" {
+	client_id = "${auth0_client.my_client.id}"
+	audience = "${auth0_resource_server.my_resource_server.identifier}"
+	scope = [ "create:foo" ]
+}
+
+resource "auth0_role" "my_role" {
+	name = "Acceptance Test - Dependents - {{.random}}"
+	permissions {
+		name = "create:foo"
+		resource_server_identifier = "${auth0_resource_server.my_resource_server.identifier}"
+	}
+}
+`
+
+// testAccCheckRolePermissionCount reads the role's permissions through the
+// Management API, as its state alone doesn't show they were reassigned.
+func testAccCheckRolePermissionCount(name string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+		api, err := Auth0()
+		if err != nil {
+			return err
+		}
+		l, err := api.Role.Permissions(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if len(l.Permissions) != expected {
+			return fmt.Errorf("Expected role to have %d permissions, got %d", expected, len(l.Permissions))
+		}
+		return nil
+	}
+}
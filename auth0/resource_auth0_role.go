@@ -39,6 +39,7 @@ func newRole() *schema.Resource {
 			"permissions": {
 				Type:     schema.TypeSet,
 				Optional: true,
+				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"name": {
@@ -59,7 +60,7 @@ func newRole() *schema.Resource {
 func createRole(d *schema.ResourceData, m interface{}) error {
 
 	c := expandRole(d)
-	api := m.(*management.Management)
+	api := m.(*providerMeta)
 	if err := api.Role.Create(c); err != nil {
 		return err
 	}
@@ -82,7 +83,7 @@ func createRole(d *schema.ResourceData, m interface{}) error {
 }
 
 func readRole(d *schema.ResourceData, m interface{}) error {
-	api := m.(*management.Management)
+	api := m.(*providerMeta)
 	c, err := api.Role.Read(d.Id())
 	if err != nil {
 		if mErr, ok := err.(management.Error); ok {
@@ -98,31 +99,36 @@ func readRole(d *schema.ResourceData, m interface{}) error {
 	d.Set("name", c.Name)
 	d.Set("description", c.Description)
 
-	var permissions []*management.Permission
+	permissions, err := readAllRolePermissions(api, d.Id())
+	if err != nil {
+		return err
+	}
+
+	d.Set("permissions", flattenRolePermissions(permissions))
+
+	return nil
+}
 
+// readAllRolePermissions reads every page of permissions assigned to a role.
+func readAllRolePermissions(api *providerMeta, id string) (permissions []*management.Permission, err error) {
 	var page int
 	for {
-		l, err := api.Role.Permissions(d.Id(), management.Page(page))
+		l, err := api.Role.Permissions(id, management.Page(page), api.perPage())
 		if err != nil {
-			return err
-		}
-		for _, permission := range l.Permissions {
-			permissions = append(permissions, permission)
+			return nil, err
 		}
+		permissions = append(permissions, l.Permissions...)
 		if !l.HasNext() {
 			break
 		}
 		page++
 	}
-
-	d.Set("permissions", flattenRolePermissions(permissions))
-
-	return nil
+	return permissions, nil
 }
 
 func updateRole(d *schema.ResourceData, m interface{}) error {
 	c := expandRole(d)
-	api := m.(*management.Management)
+	api := m.(*providerMeta)
 	err := api.Role.Update(d.Id(), c)
 	if err != nil {
 		return err
@@ -136,7 +142,7 @@ func updateRole(d *schema.ResourceData, m interface{}) error {
 }
 
 func deleteRole(d *schema.ResourceData, m interface{}) error {
-	api := m.(*management.Management)
+	api := m.(*providerMeta)
 	err := api.Role.Delete(d.Id())
 	if err != nil {
 		if mErr, ok := err.(management.Error); ok {
@@ -178,7 +184,7 @@ func assignRolePermissions(d *schema.ResourceData, m interface{}) error {
 		})
 	}
 
-	api := m.(*management.Management)
+	api := m.(*providerMeta)
 
 	if len(rmPermissions) > 0 {
 		err := api.Role.RemovePermissions(d.Id(), rmPermissions...)
@@ -0,0 +1,162 @@
+package auth0
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"gopkg.in/auth0.v4"
+	"gopkg.in/auth0.v4/management"
+)
+
+func newRolePermissions() *schema.Resource {
+	return &schema.Resource{
+
+		Create: createRolePermissions,
+		Read:   readRolePermissions,
+		Update: updateRolePermissions,
+		Delete: deleteRolePermissions,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"role_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the role the permissions are assigned to",
+			},
+			"resource_server_identifier": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Identifier of the resource server the permissions belong to",
+			},
+			"permissions": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Required:    true,
+				Description: "Names of the resource server's permissions assigned to the role",
+			},
+		},
+	}
+}
+
+func createRolePermissions(d *schema.ResourceData, m interface{}) error {
+	roleID := d.Get("role_id").(string)
+	identifier := d.Get("resource_server_identifier").(string)
+
+	api := m.(*providerMeta)
+	permissions := expandRolePermissions(identifier, Set(d, "permissions").List())
+	if err := api.Role.AssociatePermissions(roleID, permissions...); err != nil {
+		return err
+	}
+
+	d.SetId(rolePermissionsID(roleID, identifier))
+	return readRolePermissions(d, m)
+}
+
+func readRolePermissions(d *schema.ResourceData, m interface{}) error {
+	roleID, identifier, err := parseRolePermissionsID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	api := m.(*providerMeta)
+	permissions, err := readAllRolePermissions(api, roleID)
+	if err != nil {
+		if mErr, ok := err.(management.Error); ok {
+			if mErr.Status() == http.StatusNotFound {
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	// Only the permissions of this resource server are managed, permissions
+	// of other resource servers assigned to the role are left untouched.
+	var names []interface{}
+	for _, permission := range permissions {
+		if permission.GetResourceServerIdentifier() == identifier {
+			names = append(names, permission.GetName())
+		}
+	}
+
+	d.Set("role_id", roleID)
+	d.Set("resource_server_identifier", identifier)
+	d.Set("permissions", names)
+	return nil
+}
+
+func updateRolePermissions(d *schema.ResourceData, m interface{}) error {
+	roleID := d.Get("role_id").(string)
+	identifier := d.Get("resource_server_identifier").(string)
+
+	api := m.(*providerMeta)
+	add, rm := Diff(d, "permissions")
+
+	if len(rm) > 0 {
+		if err := api.Role.RemovePermissions(roleID, expandRolePermissions(identifier, rm)...); err != nil {
+			return err
+		}
+	}
+
+	if len(add) > 0 {
+		if err := api.Role.AssociatePermissions(roleID, expandRolePermissions(identifier, add)...); err != nil {
+			return err
+		}
+	}
+
+	return readRolePermissions(d, m)
+}
+
+func deleteRolePermissions(d *schema.ResourceData, m interface{}) error {
+	roleID := d.Get("role_id").(string)
+	identifier := d.Get("resource_server_identifier").(string)
+
+	permissions := expandRolePermissions(identifier, Set(d, "permissions").List())
+	if len(permissions) == 0 {
+		return nil
+	}
+
+	api := m.(*providerMeta)
+	err := api.Role.RemovePermissions(roleID, permissions...)
+	if err != nil {
+		if mErr, ok := err.(management.Error); ok {
+			if mErr.Status() == http.StatusNotFound {
+				d.SetId("")
+				return nil
+			}
+		}
+	}
+	return err
+}
+
+func expandRolePermissions(identifier string, names []interface{}) []*management.Permission {
+	permissions := make([]*management.Permission, 0, len(names))
+	for _, name := range names {
+		permissions = append(permissions, &management.Permission{
+			Name:                     auth0.String(name.(string)),
+			ResourceServerIdentifier: auth0.String(identifier),
+		})
+	}
+	return permissions
+}
+
+// Resource server identifiers are usually URLs, so the two parts are separated
+// by a double colon.
+func rolePermissionsID(roleID, identifier string) string {
+	return roleID + "::" + identifier
+}
+
+func parseRolePermissionsID(id string) (roleID, identifier string, err error) {
+	parts := strings.SplitN(id, "::", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%q), expected <role_id>::<resource_server_identifier>", id)
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,124 @@
+package auth0
+
+import (
+	"testing"
+
+	"github.com/alexkappa/terraform-provider-auth0/auth0/internal/random"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"gopkg.in/auth0.v4/management"
+)
+
+func TestAccRolePermissionsAssignment(t *testing.T) {
+
+	rand := random.String(6)
+
+	resource.Test(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"auth0": Provider(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: random.Template(testAccRolePermissionsAssignmentCreate, rand),
+				Check: resource.ComposeTestCheckFunc(
+					random.TestCheckResourceAttr("auth0_role_permissions.my_permissions", "resource_server_identifier", "https://uat.tf.alexkappa.com/role-permissions/{{.random}}", rand),
+					resource.TestCheckResourceAttr("auth0_role_permissions.my_permissions", "permissions.#", "3"),
+					resource.TestCheckResourceAttr("auth0_role_permissions.my_permissions", "permissions.2667628228", "create:foo"),
+					resource.TestCheckResourceAttr("auth0_role_permissions.my_permissions", "permissions.291996482", "read:foo"),
+					resource.TestCheckResourceAttr("auth0_role_permissions.my_permissions", "permissions.1585302845", "delete:foo"),
+				),
+			},
+			{
+				Config: random.Template(testAccRolePermissionsAssignmentUpdate, rand),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_role_permissions.my_permissions", "permissions.#", "2"),
+					resource.TestCheckResourceAttr("auth0_role_permissions.my_permissions", "permissions.2667628228", "create:foo"),
+					resource.TestCheckResourceAttr("auth0_role_permissions.my_permissions", "permissions.291996482", "read:foo"),
+				),
+			},
+			{
+				// A permission removed outside of Terraform must be detected on
+				// refresh, resulting in a plan to assign it again.
+				PreConfig: func() {
+					testAccRolePermissionsRemove(t, "Acceptance Test - Role Permissions - "+rand, "https://uat.tf.alexkappa.com/role-permissions/"+rand, "read:foo")
+				},
+				Config:             random.Template(testAccRolePermissionsAssignmentUpdate, rand),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: random.Template(testAccRolePermissionsAssignmentUpdate, rand),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_role_permissions.my_permissions", "permissions.#", "2"),
+					resource.TestCheckResourceAttr("auth0_role_permissions.my_permissions", "permissions.291996482", "read:foo"),
+				),
+			},
+			{
+				ResourceName:      "auth0_role_permissions.my_permissions",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// testAccRolePermissionsRemove removes permissions of a resource server from
+// the role with the given name, simulating a change made outside of Terraform.
+func testAccRolePermissionsRemove(t *testing.T, roleName, identifier string, names ...interface{}) {
+	api, err := Auth0()
+	if err != nil {
+		t.Fatal(err)
+	}
+	l, err := api.Role.List(management.Parameter("name_filter", roleName))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(l.Roles) != 1 {
+		t.Fatalf("Expected 1 role named %q, found %d", roleName, len(l.Roles))
+	}
+	err = api.Role.RemovePermissions(l.Roles[0].GetID(), expandRolePermissions(identifier, names)...)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+const testAccRolePermissionsAssignmentAux = `
+
+resource "auth0_resource_server" "my_resource_server" {
+	name = "Acceptance Test - Role Permissions - {{.random}}"
+	identifier = "https://uat.tf.alexkappa.com/role-permissions/{{.random}}"
+	scopes {
+		value = "create:foo"
+	}
+	scopes {
+		value = "read:foo"
+	}
+	scopes {
+		value = "delete:foo"
+	}
+}
+
+resource "auth0_role" "my_role" {
+	name = "Acceptance Test - Role Permissions - {{.random}}"
+	# permissions are computed, so those assigned by auth0_role_permissions
+	# don't cause a diff on the role.
+}
+`
+
+const testAccRolePermissionsAssignmentCreate = testAccRolePermissionsAssignmentAux + `
+
+resource "auth0_role_permissions" "my_permissions" {
+	role_id = "${auth0_role.my_role.id}"
+	resource_server_identifier = "${auth0_resource_server.my_resource_server.identifier}"
+	permissions = [ "create:foo", "read:foo", "delete:foo" ]
+}
+`
+
+const testAccRolePermissionsAssignmentUpdate = testAccRolePermissionsAssignmentAux + `
+
+resource "auth0_role_permissions" "my_permissions" {
+	role_id = "${auth0_role.my_role.id}"
+	resource_server_identifier = "${auth0_resource_server.my_resource_server.identifier}"
+	permissions = [ "create:foo", "read:foo" ]
+}
+`
@@ -52,7 +52,7 @@ func newRule() *schema.Resource {
 
 func createRule(d *schema.ResourceData, m interface{}) error {
 	c := buildRule(d)
-	api := m.(*management.Management)
+	api := m.(*providerMeta)
 	if err := api.Rule.Create(c); err != nil {
 		return err
 	}
@@ -61,7 +61,7 @@ func createRule(d *schema.ResourceData, m interface{}) error {
 }
 
 func readRule(d *schema.ResourceData, m interface{}) error {
-	api := m.(*management.Management)
+	api := m.(*providerMeta)
 	c, err := api.Rule.Read(d.Id())
 	if err != nil {
 		if mErr, ok := err.(management.Error); ok {
@@ -82,7 +82,7 @@ func readRule(d *schema.ResourceData, m interface{}) error {
 
 func updateRule(d *schema.ResourceData, m interface{}) error {
 	c := buildRule(d)
-	api := m.(*management.Management)
+	api := m.(*providerMeta)
 	err := api.Rule.Update(d.Id(), c)
 	if err != nil {
 		return err
@@ -91,7 +91,7 @@ func updateRule(d *schema.ResourceData, m interface{}) error {
 }
 
 func deleteRule(d *schema.ResourceData, m interface{}) error {
-	api := m.(*management.Management)
+	api := m.(*providerMeta)
 	err := api.Rule.Delete(d.Id())
 	if err != nil {
 		if mErr, ok := err.(management.Error); ok {
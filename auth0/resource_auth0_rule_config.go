@@ -39,7 +39,7 @@ func createRuleConfig(d *schema.ResourceData, m interface{}) error {
 	r := buildRuleConfig(d)
 	key := auth0.StringValue(r.Key)
 	r.Key = nil
-	api := m.(*management.Management)
+	api := m.(*providerMeta)
 	if err := api.RuleConfig.Upsert(key, r); err != nil {
 		return err
 	}
@@ -48,7 +48,7 @@ func createRuleConfig(d *schema.ResourceData, m interface{}) error {
 }
 
 func readRuleConfig(d *schema.ResourceData, m interface{}) error {
-	api := m.(*management.Management)
+	api := m.(*providerMeta)
 	r, err := api.RuleConfig.Read(d.Id())
 	if err != nil {
 		if mErr, ok := err.(management.Error); ok {
@@ -66,7 +66,7 @@ func readRuleConfig(d *schema.ResourceData, m interface{}) error {
 func updateRuleConfig(d *schema.ResourceData, m interface{}) error {
 	r := buildRuleConfig(d)
 	r.Key = nil
-	api := m.(*management.Management)
+	api := m.(*providerMeta)
 	err := api.RuleConfig.Upsert(d.Id(), r)
 	if err != nil {
 		return err
@@ -75,7 +75,7 @@ func updateRuleConfig(d *schema.ResourceData, m interface{}) error {
 }
 
 func deleteRuleConfig(d *schema.ResourceData, m interface{}) error {
-	api := m.(*management.Management)
+	api := m.(*providerMeta)
 	err := api.RuleConfig.Delete(d.Id())
 	if err != nil {
 		if mErr, ok := err.(management.Error); ok {
@@ -9,6 +9,7 @@ import (
 
 	"gopkg.in/auth0.v4/management"
 
+	"github.com/alexkappa/terraform-provider-auth0/auth0/internal/diff"
 	v "github.com/alexkappa/terraform-provider-auth0/auth0/internal/validation"
 )
 
@@ -36,8 +37,9 @@ func newTenant() *schema.Resource {
 							Required: true,
 						},
 						"html": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: diff.IgnoreWhitespace,
 						},
 					},
 				},
@@ -54,8 +56,9 @@ func newTenant() *schema.Resource {
 							Required: true,
 						},
 						"html": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: diff.IgnoreWhitespace,
 						},
 					},
 				},
@@ -78,8 +81,9 @@ func newTenant() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"html": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: diff.IgnoreWhitespace,
 						},
 						"show_log_link": {
 							Type:     schema.TypeBool,
@@ -103,18 +107,23 @@ func newTenant() *schema.Resource {
 				Computed: true,
 			},
 			"support_email": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.Any(validation.StringIsEmpty, v.IsEmail),
 			},
 			"support_url": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
 			},
 			"allowed_logout_urls": {
-				Type:     schema.TypeList,
-				Elem:     &schema.Schema{Type: schema.TypeString},
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					DiffSuppressFunc: diff.IgnoreURLNormalization,
+				},
 				Optional: true,
 				Computed: true,
 			},
@@ -128,6 +137,9 @@ func newTenant() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"4", "8", "12", "16", "18",
+				}, false),
 			},
 			"idle_session_lifetime": {
 				Type:         schema.TypeInt,
@@ -254,7 +266,7 @@ func createTenant(d *schema.ResourceData, m interface{}) error {
 }
 
 func readTenant(d *schema.ResourceData, m interface{}) error {
-	api := m.(*management.Management)
+	api := m.(*providerMeta)
 	t, err := api.Tenant.Read()
 	if err != nil {
 		if mErr, ok := err.(management.Error); ok {
@@ -263,7 +275,7 @@ func readTenant(d *schema.ResourceData, m interface{}) error {
 				return nil
 			}
 		}
-		return err
+		return withRequiredScope(err, "read:tenant_settings")
 	}
 
 	d.Set("change_password", flattenTenantChangePassword(t.ChangePassword))
@@ -281,6 +293,7 @@ func readTenant(d *schema.ResourceData, m interface{}) error {
 	d.Set("idle_session_lifetime", t.IdleSessionLifetime)
 	d.Set("sandbox_version", t.SandboxVersion)
 	d.Set("enabled_locales", t.EnabledLocales)
+	d.Set("default_redirection_uri", t.DefaultRedirectionURI)
 
 	d.Set("error_page", flattenTenantErrorPage(t.ErrorPage))
 	d.Set("flags", flattenTenantFlags(t.Flags))
@@ -291,7 +304,7 @@ func readTenant(d *schema.ResourceData, m interface{}) error {
 
 func updateTenant(d *schema.ResourceData, m interface{}) error {
 	t := buildTenant(d)
-	api := m.(*management.Management)
+	api := m.(*providerMeta)
 	err := api.Tenant.Update(t)
 	if err != nil {
 		return err
@@ -306,22 +319,23 @@ func deleteTenant(d *schema.ResourceData, m interface{}) error {
 
 func buildTenant(d *schema.ResourceData) *management.Tenant {
 	t := &management.Tenant{
-		DefaultAudience:     String(d, "default_audience"),
-		DefaultDirectory:    String(d, "default_directory"),
-		FriendlyName:        String(d, "friendly_name"),
-		PictureURL:          String(d, "picture_url"),
-		SupportEmail:        String(d, "support_email"),
-		SupportURL:          String(d, "support_url"),
-		AllowedLogoutURLs:   Slice(d, "allowed_logout_urls"),
-		SessionLifetime:     Int(d, "session_lifetime"),
-		SandboxVersion:      String(d, "sandbox_version"),
-		IdleSessionLifetime: Int(d, "idle_session_lifetime", IsNewResource(), HasChange()),
-		EnabledLocales:      Set(d, "enabled_locales").List(),
-		ChangePassword:      expandTenantChangePassword(d),
-		GuardianMFAPage:     expandTenantGuardianMFAPage(d),
-		ErrorPage:           expandTenantErrorPage(d),
-		Flags:               expandTenantFlags(d),
-		UniversalLogin:      expandTenantUniversalLogin(d),
+		DefaultAudience:       String(d, "default_audience"),
+		DefaultDirectory:      String(d, "default_directory"),
+		FriendlyName:          String(d, "friendly_name"),
+		PictureURL:            String(d, "picture_url"),
+		SupportEmail:          String(d, "support_email"),
+		SupportURL:            String(d, "support_url"),
+		AllowedLogoutURLs:     Slice(d, "allowed_logout_urls"),
+		SessionLifetime:       Int(d, "session_lifetime"),
+		SandboxVersion:        String(d, "sandbox_version"),
+		IdleSessionLifetime:   Int(d, "idle_session_lifetime", IsNewResource(), HasChange()),
+		EnabledLocales:        Set(d, "enabled_locales").List(),
+		ChangePassword:        expandTenantChangePassword(d),
+		GuardianMFAPage:       expandTenantGuardianMFAPage(d),
+		ErrorPage:             expandTenantErrorPage(d),
+		Flags:                 expandTenantFlags(d),
+		UniversalLogin:        expandTenantUniversalLogin(d),
+		DefaultRedirectionURI: String(d, "default_redirection_uri"),
 	}
 
 	return t
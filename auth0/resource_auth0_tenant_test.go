@@ -1,6 +1,8 @@
 package auth0
 
 import (
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
@@ -54,12 +56,75 @@ func TestAccTenant(t *testing.T) {
 					resource.TestCheckResourceAttr("auth0_tenant.my_tenant", "flags.0.disable_clickjack_protection_headers", "false"),
 					resource.TestCheckResourceAttr("auth0_tenant.my_tenant", "flags.0.enable_public_signup_user_exists_error", "true"),
 					resource.TestCheckResourceAttr("auth0_tenant.my_tenant", "flags.0.use_scope_descriptions_for_consent", "false"),
+					resource.TestCheckResourceAttr("auth0_tenant.my_tenant", "sandbox_version", "12"),
+					resource.TestCheckResourceAttr("auth0_tenant.my_tenant", "default_redirection_uri", "https://example.com/home"),
+				),
+			},
+			{
+				// Whitespace-only changes to page HTML must not produce a diff.
+				Config:             strings.Replace(testAccTenantConfigUpdate, `html = "<html>Error Page</html>"`, `html = "  <html>Error Page</html>\n"`, 1),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+		},
+	})
+}
+
+func TestAccTenantSupportEmail(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"auth0": Provider(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTenantConfigInvalidSupportEmail,
+				ExpectError: regexp.MustCompile(`expected "support_email" to be a valid email address`),
+			},
+			{
+				Config: testAccTenantConfigSupportEmail,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("auth0_tenant.my_tenant", "support_email", "help@mycompany.org"),
+					resource.TestCheckResourceAttr("auth0_tenant.my_tenant", "support_url", "https://mycompany.org/help"),
 				),
 			},
 		},
 	})
 }
 
+func TestTenantSupportEmailValidation(t *testing.T) {
+	validate := newTenant().Schema["support_email"].ValidateFunc
+	for email, valid := range map[string]bool{
+		"support@example.com":           true,
+		"":                              true,
+		"support":                       false,
+		"https://mycompany.org/support": false,
+	} {
+		_, errs := validate(email, "support_email")
+		if len(errs) > 0 && valid {
+			t.Errorf("support_email %q produced an unexpected error: %v", email, errs)
+		}
+		if len(errs) == 0 && !valid {
+			t.Errorf("support_email %q was expected to produce an error", email)
+		}
+	}
+}
+
+const testAccTenantConfigInvalidSupportEmail = `
+
+resource "auth0_tenant" "my_tenant" {
+	support_email = "https://mycompany.org/support"
+}
+`
+
+const testAccTenantConfigSupportEmail = `
+
+resource "auth0_tenant" "my_tenant" {
+	support_email = "help@mycompany.org"
+	support_url = "https://mycompany.org/help"
+}
+`
+
 const testAccTenantConfigCreate = `
 resource "auth0_tenant" "my_tenant" {
 	change_password {
@@ -130,7 +195,7 @@ resource "auth0_tenant" "my_tenant" {
 		"https://mycompany.org/logoutCallback"
 	]
 	session_lifetime = 1080
-	sandbox_version = "8"
+	sandbox_version = "12"
 	idle_session_lifetime = 720
 	enabled_locales = ["en", "de"]
 	flags {
@@ -145,6 +210,6 @@ resource "auth0_tenant" "my_tenant" {
 			page_background = "#000000"
 		}
 	}
-	default_redirection_uri = "https://example.com/login"
+	default_redirection_uri = "https://example.com/home"
 }
 `
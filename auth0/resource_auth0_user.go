@@ -118,7 +118,7 @@ func newUser() *schema.Resource {
 }
 
 func readUser(d *schema.ResourceData, m interface{}) error {
-	api := m.(*management.Management)
+	api := m.(*providerMeta)
 	u, err := api.User.Read(d.Id())
 	if err != nil {
 		if mErr, ok := err.(management.Error); ok {
@@ -175,7 +175,7 @@ func createUser(d *schema.ResourceData, m interface{}) error {
 	if err != nil {
 		return err
 	}
-	api := m.(*management.Management)
+	api := m.(*providerMeta)
 	if err := api.User.Create(u); err != nil {
 		return err
 	}
@@ -199,7 +199,7 @@ func updateUser(d *schema.ResourceData, m interface{}) error {
 	if err = validateUser(u); err != nil {
 		return err
 	}
-	api := m.(*management.Management)
+	api := m.(*providerMeta)
 	if userHasChange(u) {
 		if err := api.User.Update(d.Id(), u); err != nil {
 			return err
@@ -215,7 +215,7 @@ func updateUser(d *schema.ResourceData, m interface{}) error {
 }
 
 func deleteUser(d *schema.ResourceData, m interface{}) error {
-	api := m.(*management.Management)
+	api := m.(*providerMeta)
 	err := api.User.Delete(d.Id())
 	if err != nil {
 		if mErr, ok := err.(management.Error); ok {
@@ -327,7 +327,7 @@ func assignUserRoles(d *schema.ResourceData, m interface{}) error {
 		})
 	}
 
-	api := m.(*management.Management)
+	api := m.(*providerMeta)
 
 	if len(rmRoles) > 0 {
 		err := api.User.RemoveRoles(d.Id(), rmRoles...)
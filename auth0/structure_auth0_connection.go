@@ -1,21 +1,26 @@
 package auth0
 
 import (
+	"encoding/json"
 	"log"
 
 	"gopkg.in/auth0.v4"
 	"gopkg.in/auth0.v4/management"
 )
 
-func flattenConnectionOptions(d ResourceData, options interface{}) []interface{} {
+// connectionStrategyADFS is the strategy of ADFS connections, for which the SDK
+// has an options type but no constant.
+const connectionStrategyADFS = "adfs"
+
+func flattenConnectionOptions(d ResourceData, c *management.Connection) []interface{} {
 
 	var m interface{}
 
-	switch o := options.(type) {
+	switch o := c.Options.(type) {
 	case *management.ConnectionOptions:
 		m = flattenConnectionOptionsAuth0(d, o)
 	case *management.ConnectionOptionsGoogleOAuth2:
-		m = flattenConnectionOptionsGoogleOAuth2(o)
+		m = flattenConnectionOptionsGoogleOAuth2(o, c.RawOptions)
 	case *management.ConnectionOptionsOAuth2:
 		m = flattenConnectionOptionsOAuth2(o)
 	case *management.ConnectionOptionsFacebook:
@@ -42,6 +47,12 @@ func flattenConnectionOptions(d ResourceData, options interface{}) []interface{}
 		m = flattenConnectionOptionsAzureAD(o)
 	case *management.ConnectionOptionsSAML:
 		m = flattenConnectionOptionsSAML(o)
+	case map[string]interface{}:
+		// The SDK doesn't decode the options of every strategy it has a type
+		// for, so these are held as a map instead.
+		if d.Get("strategy").(string) == connectionStrategyADFS {
+			m = flattenConnectionOptionsADFS(o)
+		}
 	}
 
 	return []interface{}{m}
@@ -64,6 +75,7 @@ func flattenConnectionOptionsAuth0(d ResourceData, o *management.ConnectionOptio
 		"password_no_personal_info":      o.PasswordNoPersonalInfo,
 		"password_dictionary":            o.PasswordDictionary,
 		"password_complexity_options":    o.PasswordComplexityOptions,
+		"mfa":                            flattenConnectionOptionsMFA(o.MFA),
 		"enabled_database_customization": o.GetEnabledDatabaseCustomization(),
 		"brute_force_protection":         o.GetBruteForceProtection(),
 		"import_mode":                    o.GetImportMode(),
@@ -71,18 +83,44 @@ func flattenConnectionOptionsAuth0(d ResourceData, o *management.ConnectionOptio
 		"requires_username":              o.GetRequiresUsername(),
 		"custom_scripts":                 o.CustomScripts,
 		"configuration":                  Map(d, "configuration"), // does not get read back
+		"strategy_version":               o.GetStrategyVersion(),
+	}
+}
+
+func flattenConnectionOptionsMFA(mfa map[string]interface{}) []interface{} {
+	if mfa == nil {
+		return nil
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"active":                 mfa["active"],
+			"return_enroll_settings": mfa["return_enroll_settings"],
+		},
 	}
 }
 
-func flattenConnectionOptionsGoogleOAuth2(o *management.ConnectionOptionsGoogleOAuth2) interface{} {
+func flattenConnectionOptionsGoogleOAuth2(o *management.ConnectionOptionsGoogleOAuth2, raw json.RawMessage) interface{} {
 	return map[string]interface{}{
 		"client_id":         o.GetClientID(),
 		"client_secret":     o.GetClientSecret(),
 		"allowed_audiences": o.AllowedAudiences,
 		"scopes":            o.Scopes(),
+		"icon_url":          flattenConnectionOptionsIconURL(raw),
 	}
 }
 
+// flattenConnectionOptionsIconURL reads icon_url from the raw options, for the
+// strategies whose SDK options type has no field for it.
+func flattenConnectionOptionsIconURL(raw json.RawMessage) string {
+	var o struct {
+		IconURL string `json:"icon_url"`
+	}
+	if err := json.Unmarshal(raw, &o); err != nil {
+		log.Printf("[WARN]: Failed to decode icon_url from connection options: %v", err)
+	}
+	return o.IconURL
+}
+
 func flattenConnectionOptionsOAuth2(o *management.ConnectionOptionsOAuth2) interface{} {
 	return map[string]interface{}{
 		"client_id":              o.GetClientID(),
@@ -141,10 +179,7 @@ func flattenConnectionOptionsSMS(o *management.ConnectionOptionsSMS) interface{}
 		"messaging_service_sid":  o.GetMessagingServiceSID(),
 		"disable_signup":         o.GetDisableSignup(),
 		"brute_force_protection": o.GetBruteForceProtection(),
-		"totp": map[string]interface{}{
-			"time_step": o.OTP.GetTimeStep(),
-			"length":    o.OTP.GetLength(),
-		},
+		"totp":                   flattenConnectionOptionsOTP(o.OTP),
 	}
 }
 
@@ -176,9 +211,18 @@ func flattenConnectionOptionsEmail(o *management.ConnectionOptionsEmail) interfa
 		"template":               o.GetEmail().GetBody(),
 		"disable_signup":         o.GetDisableSignup(),
 		"brute_force_protection": o.GetBruteForceProtection(),
-		"totp": map[string]interface{}{
-			"time_step": o.OTP.GetTimeStep(),
-			"length":    o.OTP.GetLength(),
+		"totp":                   flattenConnectionOptionsOTP(o.OTP),
+	}
+}
+
+func flattenConnectionOptionsOTP(o *management.ConnectionOptionsOTP) []interface{} {
+	if o == nil {
+		return nil
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"time_step": o.GetTimeStep(),
+			"length":    o.GetLength(),
 		},
 	}
 }
@@ -215,16 +259,29 @@ func flattenConnectionOptionsAzureAD(o *management.ConnectionOptionsAzureAD) int
 	}
 }
 
+func flattenConnectionOptionsADFS(raw map[string]interface{}) interface{} {
+	o := new(management.ConnectionOptionsADFS)
+	if b, err := json.Marshal(raw); err == nil {
+		if err := json.Unmarshal(b, o); err != nil {
+			log.Printf("[WARN]: Failed to decode adfs connection options: %v", err)
+		}
+	}
+	return map[string]interface{}{
+		"tenant_domain":            o.GetTenantDomain(),
+		"domain_aliases":           o.DomainAliases,
+		"icon_url":                 o.GetLogoURL(),
+		"adfs_server":              o.GetADFSServer(),
+		"api_enable_users":         o.GetEnableUsersAPI(),
+		"set_user_root_attributes": o.GetSetUserAttributes(),
+	}
+}
+
 func flattenConnectionOptionsSAML(o *management.ConnectionOptionsSAML) interface{} {
 	return map[string]interface{}{
-		"signing_cert":     o.GetSigningCert(),
-		"protocol_binding": o.GetProtocolBinding(),
-		"debug":            o.GetDebug(),
-		"idp_initiated": map[string]interface{}{
-			"client_id":              o.IdpInitiated.GetClientID(),
-			"client_protocol":        o.IdpInitiated.GetClientProtocol(),
-			"client_authorize_query": o.IdpInitiated.GetClientAuthorizeQuery(),
-		},
+		"signing_cert":        o.GetSigningCert(),
+		"protocol_binding":    o.GetProtocolBinding(),
+		"debug":               o.GetDebug(),
+		"idp_initiated":       flattenConnectionOptionsSAMLIdpInitiated(o.IdpInitiated),
 		"tenant_domain":       o.GetTenantDomain(),
 		"domain_aliases":      o.DomainAliases,
 		"sign_in_endpoint":    o.GetSignInEndpoint(),
@@ -239,16 +296,37 @@ func flattenConnectionOptionsSAML(o *management.ConnectionOptionsSAML) interface
 	}
 }
 
+func flattenConnectionOptionsSAMLIdpInitiated(o *management.ConnectionOptionsSAMLIdpInitiated) []interface{} {
+	if o == nil {
+		return nil
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"client_id":              o.GetClientID(),
+			"client_protocol":        o.GetClientProtocol(),
+			"client_authorize_query": o.GetClientAuthorizeQuery(),
+		},
+	}
+}
+
 func expandConnection(d ResourceData) *management.Connection {
 
 	c := &management.Connection{
 		Name:               String(d, "name", IsNewResource()),
 		Strategy:           String(d, "strategy", IsNewResource()),
+		DisplayName:        String(d, "display_name"),
 		IsDomainConnection: Bool(d, "is_domain_connection"),
-		EnabledClients:     Set(d, "enabled_clients").List(),
+		EnabledClients:     Set(d, "enabled_clients", IsNewResource(), HasChange()).List(),
 		Realms:             Slice(d, "realms", IsNewResource(), HasChange()),
 	}
 
+	// Metadata is sent as a whole, including when it's emptied, so that keys
+	// removed from the configuration are removed from the connection.
+	if d.IsNewResource() || d.HasChange("metadata") {
+		metadata := d.Get("metadata")
+		c.Metadata = &metadata
+	}
+
 	s := d.Get("strategy").(string)
 
 	List(d, "options").Elem(func(d ResourceData) {
@@ -256,7 +334,7 @@ func expandConnection(d ResourceData) *management.Connection {
 		case management.ConnectionStrategyAuth0:
 			c.Options = expandConnectionOptionsAuth0(d)
 		case management.ConnectionStrategyGoogleOAuth2:
-			c.Options = expandConnectionOptionsGoogleOAuth2(d)
+			c.Options = expandConnectionOptionsIconURL(d, expandConnectionOptionsGoogleOAuth2(d))
 		case management.ConnectionStrategyOAuth2:
 			c.Options = expandConnectionOptionsOAuth2(d)
 		case management.ConnectionStrategyFacebook:
@@ -280,6 +358,8 @@ func expandConnection(d ResourceData) *management.Connection {
 			c.Options = expandConnectionOptionsAD(d)
 		case management.ConnectionStrategyAzureAD:
 			c.Options = expandConnectionOptionsAzureAD(d)
+		case connectionStrategyADFS:
+			c.Options = expandConnectionOptionsADFS(d)
 		case management.ConnectionStrategyEmail:
 			c.Options = expandConnectionOptionsEmail(d)
 		case management.ConnectionStrategySAML:
@@ -294,6 +374,58 @@ func expandConnection(d ResourceData) *management.Connection {
 	return c
 }
 
+// expandConnectionOptionsIconURL adds icon_url to the options, for the
+// strategies whose SDK options type has no field for it. The options are
+// converted to a map to hold it.
+func expandConnectionOptionsIconURL(d ResourceData, options interface{}) interface{} {
+	iconURL := String(d, "icon_url")
+	if iconURL == nil {
+		return options
+	}
+
+	b, err := json.Marshal(options)
+	if err != nil {
+		log.Printf("[WARN]: Failed to encode connection options: %v", err)
+		return options
+	}
+
+	var m map[string]interface{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		log.Printf("[WARN]: Failed to encode connection options: %v", err)
+		return options
+	}
+
+	m["icon_url"] = *iconURL
+	return m
+}
+
+// expandConnectionOptionsJSON merges the raw options held by options_json into
+// the options of the connection. Options set in the options block take
+// precedence over the raw ones.
+func expandConnectionOptionsJSON(d ResourceData, c *management.Connection) error {
+	raw, err := JSON(d, "options_json")
+	if err != nil || raw == nil {
+		return err
+	}
+
+	b, err := json.Marshal(c.Options)
+	if err != nil {
+		return err
+	}
+
+	var typed map[string]interface{}
+	if err := json.Unmarshal(b, &typed); err != nil {
+		return err
+	}
+
+	for k, v := range typed {
+		raw[k] = v
+	}
+
+	c.Options = raw
+	return nil
+}
+
 func expandConnectionOptionsGitHub(d ResourceData) *management.ConnectionOptionsGitHub {
 	o := &management.ConnectionOptionsGitHub{
 		ClientID:          String(d, "client_id"),
@@ -335,6 +467,12 @@ func expandConnectionOptionsAuth0(d ResourceData) *management.ConnectionOptions
 		o.PasswordComplexityOptions["min_length"] = Int(d, "min_length")
 	})
 
+	List(d, "mfa").Elem(func(d ResourceData) {
+		o.MFA = make(map[string]interface{})
+		o.MFA["active"] = Bool(d, "active")
+		o.MFA["return_enroll_settings"] = Bool(d, "return_enroll_settings")
+	})
+
 	o.EnabledDatabaseCustomization = Bool(d, "enabled_database_customization")
 	o.BruteForceProtection = Bool(d, "brute_force_protection")
 	o.ImportMode = Bool(d, "import_mode")
@@ -342,6 +480,7 @@ func expandConnectionOptionsAuth0(d ResourceData) *management.ConnectionOptions
 	o.RequiresUsername = Bool(d, "requires_username")
 	o.CustomScripts = Map(d, "custom_scripts")
 	o.Configuration = Map(d, "configuration")
+	o.StrategyVersion = Int(d, "strategy_version")
 
 	return o
 }
@@ -497,6 +636,17 @@ func expandConnectionOptionsAD(d ResourceData) *management.ConnectionOptionsAD {
 	return o
 }
 
+func expandConnectionOptionsADFS(d ResourceData) *management.ConnectionOptionsADFS {
+	return &management.ConnectionOptionsADFS{
+		TenantDomain:      String(d, "tenant_domain"),
+		DomainAliases:     Set(d, "domain_aliases").List(),
+		LogoURL:           String(d, "icon_url"),
+		ADFSServer:        String(d, "adfs_server"),
+		EnableUsersAPI:    Bool(d, "api_enable_users"),
+		SetUserAttributes: String(d, "set_user_root_attributes"),
+	}
+}
+
 func expandConnectionOptionsAzureAD(d ResourceData) *management.ConnectionOptionsAzureAD {
 
 	o := &management.ConnectionOptionsAzureAD{
@@ -8,5 +8,6 @@ require (
 	github.com/dustin/go-humanize v1.0.0 // indirect
 	github.com/hashicorp/go-multierror v1.1.0
 	github.com/hashicorp/terraform-plugin-sdk v1.16.0
+	golang.org/x/oauth2 v0.0.0-20200107190931-bf48bf16ab8d
 	gopkg.in/auth0.v4 v4.6.0
 )